@@ -0,0 +1,109 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// apiErrorContextTransport annotates failed Fastly API responses with the
+// identifying metadata go-fastly's HTTPError discards: the failed call and the
+// request ID and rate-limit state Fastly support asks for when
+// troubleshooting. The metadata is folded into the response body itself, so
+// each error carries its own context — resources apply in parallel, and any
+// shared "most recent failure" bookkeeping would stamp one resource's request
+// ID onto another's error.
+type apiErrorContextTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *apiErrorContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+
+	parts := []string{fmt.Sprintf("%s %s returned %d", req.Method, req.URL.Path, resp.StatusCode)}
+	requestID := resp.Header.Get("Fastly-Trace-Id")
+	if requestID == "" {
+		requestID = resp.Header.Get("X-Request-Id")
+	}
+	if requestID != "" {
+		parts = append(parts, fmt.Sprintf("request id %s", requestID))
+	}
+	if remaining := resp.Header.Get("Fastly-RateLimit-Remaining"); remaining != "" {
+		parts = append(parts, fmt.Sprintf("rate limit remaining %s", remaining))
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		body, _ = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+
+	msg, detail := apiErrorMessage(body, http.StatusText(resp.StatusCode))
+	if detail != "" {
+		detail += "; "
+	}
+	detail += strings.Join(parts, ", ")
+
+	// Re-encode in the legacy {"msg": ..., "detail": ...} shape that go-fastly
+	// parses into its HTTPError, so the metadata travels with the error
+	// through every call site without any of them changing.
+	rewritten, jsonErr := json.Marshal(map[string]string{"msg": msg, "detail": detail})
+	if jsonErr != nil {
+		// Can't happen for a map of strings, but never eat the response.
+		rewritten = body
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(rewritten))
+	resp.ContentLength = int64(len(rewritten))
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Header.Set("Content-Length", strconv.Itoa(len(rewritten)))
+	return resp, nil
+}
+
+// apiErrorMessage extracts the message and detail from an error response
+// body, tolerating both the legacy and jsonapi error shapes. fallback is used
+// as the message when the body yields none.
+func apiErrorMessage(body []byte, fallback string) (msg, detail string) {
+	msg = fallback
+
+	var legacy struct {
+		Msg    string `json:"msg"`
+		Detail string `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &legacy); err == nil && legacy.Msg != "" {
+		return legacy.Msg, legacy.Detail
+	}
+
+	var jsonapi struct {
+		Errors []struct {
+			Title  string `json:"title"`
+			Detail string `json:"detail"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &jsonapi); err == nil && len(jsonapi.Errors) > 0 {
+		if jsonapi.Errors[0].Title != "" {
+			msg = jsonapi.Errors[0].Title
+		}
+		return msg, jsonapi.Errors[0].Detail
+	}
+
+	return msg, ""
+}
+
+// wrapAPIError annotates an error with what was being processed. The request
+// metadata itself rides inside the API error, added by
+// apiErrorContextTransport; the original error remains available for
+// unwrapping.
+func wrapAPIError(err error, context string) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %w", context, err)
+}
@@ -0,0 +1,78 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorContextTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Fastly-Trace-Id", "trace-123")
+		w.Header().Set("Fastly-RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"msg": "Duplicate record", "detail": "name already taken"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiErrorContextTransport{transport: http.DefaultTransport},
+	}
+
+	resp, err := client.Get(server.URL + "/service/abc/domain")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "Duplicate record")
+	assert.Contains(t, string(body), "name already taken")
+	assert.Contains(t, string(body), "GET /service/abc/domain returned 409")
+	assert.Contains(t, string(body), "request id trace-123")
+	assert.Contains(t, string(body), "rate limit remaining 42")
+}
+
+func TestAPIErrorContextTransportLeavesSuccessesAlone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "ok"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &apiErrorContextTransport{transport: http.DefaultTransport},
+	}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"status": "ok"}`, string(body))
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		body       string
+		wantMsg    string
+		wantDetail string
+	}{
+		{"legacy", `{"msg": "Forbidden", "detail": "no access"}`, "Forbidden", "no access"},
+		{"jsonapi", `{"errors": [{"title": "Bad Request", "detail": "wrong customer"}]}`, "Bad Request", "wrong customer"},
+		{"empty", ``, "Conflict", ""},
+		{"garbage", `not json`, "Conflict", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, detail := apiErrorMessage([]byte(tc.body), "Conflict")
+			assert.Equal(t, tc.wantMsg, msg)
+			assert.Equal(t, tc.wantDetail, detail)
+		})
+	}
+}
@@ -4,10 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 var fastlyNoServiceFoundErr = errors.New("No matching Fastly Service found")
@@ -135,6 +141,27 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 		Delete:   resourceDelete(serviceDef),
 		Importer: resourceImport(serviceDef),
 
+		CustomizeDiff: customdiff.All(
+			diagnoseVersionCloneCauses(serviceDef),
+			validateUniqueNestedNames(serviceDef),
+			validateComputePackage(serviceDef),
+			validateHealthcheckConstraints,
+			validateHeaderPriorityConflicts,
+			validateResponseObjectContent,
+			validateBackendSecretFiles,
+			validateSingleMainVCL,
+			validateCrossBlockReferences,
+		),
+
+		// The create/update timeouts bound the provider's own waits — the
+		// domain-takeover retry at activation and the activation gate probes —
+		// and can be adjusted via a `timeouts` block. Delete issues single API
+		// calls with no waits, so no delete timeout is declared.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -150,9 +177,17 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 			},
 
 			"version_comment": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Description field for the version",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Description field for the version",
+				ConflictsWith: []string{"version_comment_template"},
+			},
+
+			"version_comment_template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Template for the comment stamped on each version the provider creates, giving the Fastly version history an audit trail. The provider fills in `{version}`, `{service_id}`, `{service_name}` and `{timestamp}` (RFC 3339, UTC); anything else, such as a workspace name or git SHA, can be interpolated into the template by Terraform. Conflicts with `version_comment`",
+				ConflictsWith: []string{"version_comment"},
 			},
 
 			// Active Version represents the currently activated version in Fastly. In
@@ -177,6 +212,59 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Description: "The latest cloned version by the provider. The value gets only set after running `terraform apply`",
 			},
 
+			// Staged Version is a draft newer than the active version, e.g.
+			// the one produced by an apply with `activate = false`.
+			"staged_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The latest draft version of the service when it differs from the active version, e.g. after an apply with `activate = false`. `0` when there is none",
+			},
+
+			"active_version_created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time-stamp (RFC 3339, GMT) when the currently active version was created",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time-stamp (RFC 3339, GMT) when the service was last updated",
+			},
+
+			// Read Latest Version switches drift detection from the active
+			// version to the latest version, for workflows that stage draft
+			// versions outside Terraform and want refresh to reflect them.
+			"read_latest_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Refresh state from the latest version of the service instead of the active version, so draft versions staged outside Terraform drive drift detection. Default `false`",
+			},
+
+			// Read Version is the version number refresh ran against: the active
+			// version when one exists, otherwise the latest editable version.
+			"read_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the service the last refresh read its state from. Matches `active_version` when the service is activated, otherwise the latest version",
+			},
+
+			// Previous Active Version is tracked so a bad deploy can be rolled
+			// back, either manually or via `auto_rollback`.
+			"previous_active_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version that was active before the last activation performed by the provider",
+			},
+
+			"auto_rollback": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Re-activate the previously active version if a step after activation fails. Default `false`",
+			},
+
 			"activate": {
 				Type:        schema.TypeBool,
 				Description: "Conditionally prevents the Service from being activated. The apply step will continue to create a new draft version but will not activate it if this is set to `false`. Default `true`",
@@ -184,11 +272,113 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Optional:    true,
 			},
 
+			// Surfaced in plan output so reviewers can see the blast radius
+			// of an apply; see diagnoseVersionCloneCauses.
+			"pending_version_changes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The block types whose changes in the current plan require cloning and activating a new service version, as opposed to versionless changes such as the service name or comment",
+			},
+
+			"allow_domain_takeover": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Retry domain-conflict errors during apply for up to two minutes, so a domain being moved here from another service managed in the same run can be released first. Default `false`",
+			},
+
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When `true`, any attempt to delete this service fails until the attribute is set back to `false` in an applied configuration. Unlike `lifecycle.prevent_destroy`, this also guards against the resource being renamed or removed from configuration. Default `false`",
+			},
+
 			"force_destroy": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Services that are active cannot be destroyed. In order to destroy the Service, set `force_destroy` to `true`. Default `false`",
 			},
+
+			"managed_block_types": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "An allow-list of block types (e.g. `backend`, `domain`, `snippet`) managed by this resource. Block types not listed are neither refreshed nor modified, so they can be managed outside Terraform. An empty list (the default) manages every block type",
+			},
+
+			"activation_gate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "HTTP probes evaluated after a new version of the service has been activated. If any probe fails, the apply fails (and `auto_rollback`, when enabled, re-activates the previous version), so bad deploys are caught by the apply itself",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The URL to probe, typically a health or smoke-test endpoint on one of the service's domains",
+						},
+						"expected_status": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      200,
+							Description:  "The HTTP status code the probe must return. Default `200`",
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+						"retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							Description:  "How many times to retry a failing probe before giving up. Default `3`",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retry_delay_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							Description:  "Seconds to wait between probe attempts. Default `5`",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							Description:  "Seconds before an individual probe request times out. Default `10`",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"purge_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A set of surrogate keys to purge after a new version of the service has been activated, so cache invalidation for changed content happens as part of the apply",
+			},
+
+			"version_retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Number of inactive draft versions to retain beyond the version being worked on; older drafts are deleted after each apply. Long-lived services otherwise accumulate hundreds of abandoned versions. `0` (the default) disables pruning",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"purge_all_on_activation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Purge all cached content after a new version of the service has been activated. Takes precedence over `purge_keys`. Default `false`",
+			},
+
+			"validate_vcl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Conditionally submits changed custom VCL and snippets to the Fastly VCL validation endpoint before a new version is cloned, surfacing compilation errors without using up a service version. Default `false`",
+			},
 		},
 	}
 
@@ -199,9 +389,52 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 		a.Register(s) // Mutates s, adding handler-specific schema items to the list.
 	}
 
+	// Nested block sets keep the SDK's default content-based hash. Hashing on
+	// `name` alone reads better in plans (an edit shows as an update instead
+	// of a destroy/create pair), but it also makes two same-named blocks hash
+	// identically, so the SDK collapses them into one before any validation
+	// runs — silently dropping config. Content hashing keeps duplicates
+	// distinct, letting validateUniqueNestedNames reject them at plan time.
+
+	// Version the schema explicitly so attribute renames and type changes can
+	// ship with a state upgrader instead of forcing users to taint or
+	// re-import. The upgrader list is appended to as the schema evolves.
+	s.SchemaVersion = 1
+	s.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			// The v0 schema differs from v1 only by absent attributes, so the
+			// current implied type decodes v0 states as well.
+			Type:    s.CoreConfigSchema().ImpliedType(),
+			Upgrade: upgradeServiceStateV0toV1,
+		},
+	}
+
 	return s
 }
 
+// upgradeServiceStateV0toV1 backfills the provider bookkeeping attributes
+// introduced since the original schema, so existing states upgrade in place.
+func upgradeServiceStateV0toV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	for key, def := range map[string]interface{}{
+		"auto_rollback":           false,
+		"deletion_protection":     false,
+		"purge_all_on_activation": false,
+		"read_latest_version":     false,
+		"validate_vcl":            false,
+	} {
+		if v, ok := rawState[key]; !ok || v == nil {
+			rawState[key] = def
+		}
+	}
+
+	return rawState, nil
+}
+
 // resourceCreate satisfies the Terraform resource schema Create "interface"
 // while injecting the ServiceDefinition into the true Create functionality.
 func resourceCreate(serviceDef ServiceDefinition) schema.CreateFunc {
@@ -239,15 +472,63 @@ func resourceDelete(serviceDef ServiceDefinition) schema.DeleteFunc {
 func resourceImport(serviceDef ServiceDefinition) *schema.ResourceImporter {
 	return &schema.ResourceImporter{
 		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			// Check the service's actual type before reading anything, so
+			// importing a Compute service into a VCL resource (or vice versa)
+			// fails with a pointer to the right resource instead of filling
+			// state with the wrong attribute set.
+			client := m.(*FastlyClient)
+			s, err := client.serviceDetails.Get(client.conn, d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("error looking up service (%s) for import: %s", d.Id(), err)
+			}
+			if s.Type != serviceDef.GetType() {
+				return nil, fmt.Errorf("service %s is a %s service; import it into a %s resource instead", d.Id(), serviceTypeLabel(s.Type), serviceResourceForType(s.Type))
+			}
+
 			error := resourceServiceRead(d, m, serviceDef, true)
 			if error != nil {
 				return nil, error
 			}
+
+			// Provider-only knobs have no API side to read from; set the
+			// defaults explicitly so state is complete and config generated
+			// from an import (`terraform plan -generate-config-out`) applies
+			// cleanly.
+			if err := d.Set("activate", true); err != nil {
+				return nil, err
+			}
+
 			return []*schema.ResourceData{d}, nil
 		},
 	}
 }
 
+// serviceTypeLabel translates an API service type into the name users know it
+// by.
+func serviceTypeLabel(t string) string {
+	switch t {
+	case ServiceTypeCompute:
+		return "Compute"
+	case ServiceTypeVCL:
+		return "VCL"
+	default:
+		return t
+	}
+}
+
+// serviceResourceForType names the Terraform resource matching an API service
+// type, for error messages that steer users to the right one.
+func serviceResourceForType(t string) string {
+	switch t {
+	case ServiceTypeCompute:
+		return "fastly_service_compute"
+	case ServiceTypeVCL:
+		return "fastly_service_v1"
+	default:
+		return t
+	}
+}
+
 // resourceServiceCreate provides service resource Create functionality.
 func resourceServiceCreate(d *schema.ResourceData, meta interface{}, serviceDef ServiceDefinition) error {
 	if err := validateVCLs(d); err != nil {
@@ -275,7 +556,21 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 		return err
 	}
 
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	// Serialize against other resources operating on the same service.
+	client.serviceLocks.Lock(d.Id())
+	defer client.serviceLocks.Unlock(d.Id())
+
+	// Optionally run changed custom VCL through the Fastly validation endpoint
+	// before cloning, so compilation errors are caught before a new version is
+	// created.
+	if serviceDef.GetType() == ServiceTypeVCL && d.Get("validate_vcl").(bool) {
+		if err := lintCustomVCL(d, conn); err != nil {
+			return err
+		}
+	}
 
 	// Update Name and/or Comment. No new version is required for this.
 	if d.HasChange("name") || d.HasChange("comment") {
@@ -296,6 +591,9 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 	// version. We only need one change to trigger this, so a break is OK.
 	var needsChange bool
 	for _, a := range serviceDef.GetAttributeHandler() {
+		if !isAttributeManaged(d, a) {
+			continue
+		}
 		if a.HasChange(d) {
 			needsChange = true
 			break
@@ -324,109 +622,369 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 		}
 	}
 
-	initialVersion := false
+	// Only versioned attributes (those managed by attribute handlers) force a
+	// clone. Service-level fields such as name, comment or the provider's own
+	// bookkeeping attributes are updated in place above without burning a
+	// version.
+	if !needsChange {
+		log.Printf("[DEBUG] No versioned attributes changed for Fastly Service (%s), skipping version clone", d.Id())
+	}
 
 	if needsChange {
-		latestVersion := d.Get("active_version").(int)
-		if latestVersion == 0 {
-			initialVersion = true
-			// If the service was just created, there is an empty Version 1 available
-			// that is unlocked and can be updated.
-			latestVersion = 1
-		} else {
-			// Clone the latest version, giving us an unlocked version we can modify.
-			log.Printf("[DEBUG] Creating clone of version (%d) for updates", latestVersion)
-			newVersion, err := conn.CloneVersion(&gofastly.CloneVersionInput{
+		// A concurrent change (another Terraform run, or a manual activation in
+		// the Fastly UI) can lock the version we are editing mid-flight, which
+		// surfaces as a 409 from the API. Re-clone from the now-latest version
+		// and retry once before giving up.
+		err := resourceServiceProcessVersion(d, conn, serviceDef)
+		if isVersionLockedError(err) {
+			log.Printf("[WARN] Version locked while updating Fastly Service (%s), re-cloning and retrying: %s", d.Id(), err)
+			err = resourceServiceProcessVersion(d, conn, serviceDef)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// The mutations above make any cached service details stale; drop them so
+	// the final read reflects the new state.
+	client.serviceDetails.Invalidate(d.Id())
+
+	return resourceServiceRead(d, meta, serviceDef, false)
+}
+
+// renderVersionComment fills the provider-side placeholders in
+// version_comment_template for the version being created.
+func renderVersionComment(d *schema.ResourceData, version int) string {
+	return strings.NewReplacer(
+		"{version}", strconv.Itoa(version),
+		"{service_id}", d.Id(),
+		"{service_name}", d.Get("name").(string),
+		"{timestamp}", time.Now().UTC().Format(time.RFC3339),
+	).Replace(d.Get("version_comment_template").(string))
+}
+
+// isVersionLockedError returns whether an error from the Fastly API indicates
+// that the service version being modified has been locked by activation.
+func isVersionLockedError(err error) bool {
+	var httpErr *gofastly.HTTPError
+	return errors.As(err, &httpErr) && httpErr.StatusCode == 409
+}
+
+// resourceServiceProcessVersion clones the active version when one exists,
+// delegates processing of the changed attributes to the attribute handlers,
+// validates the result and (unless `activate` is false) activates it.
+func resourceServiceProcessVersion(d *schema.ResourceData, conn *gofastly.Client, serviceDef ServiceDefinition) error {
+	initialVersion := false
+
+	latestVersion := d.Get("active_version").(int)
+	if latestVersion == 0 {
+		initialVersion = true
+		// If the service was just created, there is an empty Version 1 available
+		// that is unlocked and can be updated.
+		latestVersion = 1
+	} else {
+		// Clone the latest version, giving us an unlocked version we can modify.
+		log.Printf("[DEBUG] Creating clone of version (%d) for updates", latestVersion)
+		newVersion, err := conn.CloneVersion(&gofastly.CloneVersionInput{
+			ServiceID:      d.Id(),
+			ServiceVersion: latestVersion,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The new version number is named "Number", but it's actually a string.
+		latestVersion = newVersion.Number
+		d.Set("cloned_version", latestVersion)
+
+		// New versions are not immediately found in the API, or are not
+		// immediately mutable, so we need to sleep a few and let Fastly ready
+		// itself. Typically, 7 seconds is enough.
+		log.Print("[DEBUG] Sleeping 7 seconds to allow Fastly Version to be available")
+		time.Sleep(7 * time.Second)
+
+		// Update the cloned version's comment.
+		if d.Get("version_comment").(string) != "" {
+			opts := gofastly.UpdateVersionInput{
 				ServiceID:      d.Id(),
 				ServiceVersion: latestVersion,
-			})
+				Comment:        gofastly.String(d.Get("version_comment").(string)),
+			}
+
+			log.Printf("[DEBUG] Update Version opts: %#v", opts)
+			_, err := conn.UpdateVersion(&opts)
 			if err != nil {
 				return err
 			}
+		}
+	}
 
-			// The new version number is named "Number", but it's actually a string.
-			latestVersion = newVersion.Number
-			d.Set("cloned_version", latestVersion)
-
-			// New versions are not immediately found in the API, or are not
-			// immediately mutable, so we need to sleep a few and let Fastly ready
-			// itself. Typically, 7 seconds is enough.
-			log.Print("[DEBUG] Sleeping 7 seconds to allow Fastly Version to be available")
-			time.Sleep(7 * time.Second)
-
-			// Update the cloned version's comment.
-			if d.Get("version_comment").(string) != "" {
-				opts := gofastly.UpdateVersionInput{
-					ServiceID:      d.Id(),
-					ServiceVersion: latestVersion,
-					Comment:        gofastly.String(d.Get("version_comment").(string)),
-				}
+	// Stamp the version with the rendered comment template. Unlike the static
+	// version_comment above this also covers the initial version, and it is
+	// re-rendered for every version the provider creates.
+	if d.Get("version_comment_template").(string) != "" {
+		opts := gofastly.UpdateVersionInput{
+			ServiceID:      d.Id(),
+			ServiceVersion: latestVersion,
+			Comment:        gofastly.String(renderVersionComment(d, latestVersion)),
+		}
 
-				log.Printf("[DEBUG] Update Version opts: %#v", opts)
-				_, err := conn.UpdateVersion(&opts)
-				if err != nil {
-					return err
-				}
-			}
+		log.Printf("[DEBUG] Update Version opts: %#v", opts)
+		if _, err := conn.UpdateVersion(&opts); err != nil {
+			return err
 		}
+	}
 
-		// This delegates the bulk of processing to attribute handlers which manage state
-		// for their own attributes.
-		for _, a := range serviceDef.GetAttributeHandler() {
-			if a.MustProcess(d, initialVersion) {
-				if err := a.Process(d, latestVersion, conn); err != nil {
-					return err
+	// This delegates the bulk of processing to attribute handlers which manage state
+	// for their own attributes.
+	for _, a := range serviceDef.GetAttributeHandler() {
+		if !isAttributeManaged(d, a) {
+			continue
+		}
+		if a.MustProcess(d, initialVersion) {
+			if err := a.Process(d, latestVersion, conn); err != nil {
+				block := "service settings"
+				if ka, ok := a.(interface{ GetKey() string }); ok && ka.GetKey() != "" {
+					block = fmt.Sprintf("%s blocks", ka.GetKey())
 				}
+				return wrapAPIError(err, fmt.Sprintf("[ERR] Error processing %s for Fastly Service (%s), version (%d)", block, d.Id(), latestVersion))
 			}
 		}
+	}
 
-		// Validate version.
-		log.Printf("[DEBUG] Validating Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
-		valid, msg, err := conn.ValidateVersion(&gofastly.ValidateVersionInput{
-			ServiceID:      d.Id(),
-			ServiceVersion: latestVersion,
-		})
+	// Validate version.
+	log.Printf("[DEBUG] Validating Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
+	valid, msg, err := conn.ValidateVersion(&gofastly.ValidateVersionInput{
+		ServiceID:      d.Id(),
+		ServiceVersion: latestVersion,
+	})
 
-		if err != nil {
-			return fmt.Errorf("[ERR] Error checking validation: %s", err)
-		}
+	if err != nil {
+		return fmt.Errorf("[ERR] Error checking validation: %s", err)
+	}
 
-		if !valid {
-			return fmt.Errorf("[ERR] Invalid configuration for Fastly Service (%s): %s", d.Id(), msg)
-		}
+	if !valid {
+		return fmt.Errorf("[ERR] Invalid configuration for Fastly Service (%s), version (%v) was not activated: %s", d.Id(), latestVersion, msg)
+	}
+
+	shouldActivate := d.Get("activate").(bool)
+	if shouldActivate {
+		previousActiveVersion := d.Get("active_version").(int)
+
+		// The declared create/update timeouts bound the waits below.
+		opDeadline := time.Now().Add(operationTimeout(d))
 
-		shouldActivate := d.Get("activate").(bool)
-		if shouldActivate {
-			log.Printf("[DEBUG] Activating Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
-			_, err = conn.ActivateVersion(&gofastly.ActivateVersionInput{
+		log.Printf("[DEBUG] Activating Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
+		// Domain conflicts at activation are retried when allow_domain_takeover
+		// is set, giving a service releasing the domain in the same run time
+		// to activate first.
+		takeoverTimeout := domainTakeoverTimeout
+		if remaining := time.Until(opDeadline); remaining < takeoverTimeout {
+			takeoverTimeout = remaining
+		}
+		err = retryDomainConflict(d.Get("allow_domain_takeover").(bool), takeoverTimeout, fmt.Sprintf("Activating Fastly Service (%s)", d.Id()), func() error {
+			_, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
 				ServiceID:      d.Id(),
 				ServiceVersion: latestVersion,
 			})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error activating version (%d): %s", latestVersion, err)
+		}
+
+		// Only if the version is valid and activated do we set the active_version.
+		// This prevents us from getting stuck in cloning an invalid version.
+		d.Set("previous_active_version", previousActiveVersion)
+		d.Set("active_version", latestVersion)
+
+		// Purge cached content for the newly activated version, so changed
+		// response objects, dictionaries etc. take effect without a manual
+		// post-deploy purge.
+		if err := purgeAfterActivation(d, conn); err != nil {
+			return rollbackOnFailure(d, conn, previousActiveVersion, err)
+		}
+
+		// Probe the configured activation gates so a bad deploy fails the
+		// apply (and rolls back, when enabled) instead of being discovered
+		// by monitoring.
+		if err := runActivationGates(d, opDeadline); err != nil {
+			return rollbackOnFailure(d, conn, previousActiveVersion, err)
+		}
+	} else {
+		log.Printf("[INFO] Skipping activation of Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
+		log.Print("[INFO] The Terraform definition is explicitly specified to not activate the changes on Fastly")
+		log.Printf("[INFO] Version (%v) has been pushed and validated", latestVersion)
+		log.Printf("[INFO] Visit https://manage.fastly.com/configure/services/%s/versions/%v and activate it manually", d.Id(), latestVersion)
+	}
+
+	pruneDraftVersions(d, conn, latestVersion)
+
+	return nil
+}
+
+// pruneDraftVersions deletes inactive, unlocked draft versions beyond the
+// `version_retention` count, keeping the most recent drafts. The version being
+// worked on and the active version are never candidates, and locked versions
+// (anything ever activated) cannot be deleted by the API anyway. Pruning is
+// housekeeping, so failures are logged rather than failing an apply whose
+// configuration change already succeeded.
+func pruneDraftVersions(d *schema.ResourceData, conn *gofastly.Client, latestVersion int) {
+	retention, ok := d.GetOk("version_retention")
+	if !ok {
+		return
+	}
+
+	versions, err := conn.ListVersions(&gofastly.ListVersionsInput{
+		ServiceID: d.Id(),
+	})
+	if err != nil {
+		log.Printf("[WARN] Error listing versions for Fastly Service (%s) while pruning drafts: %s", d.Id(), err)
+		return
+	}
+
+	var drafts []int
+	for _, v := range versions {
+		if v.Active || v.Locked || v.Number == latestVersion || v.Number == d.Get("active_version").(int) {
+			continue
+		}
+		drafts = append(drafts, v.Number)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(drafts)))
+
+	if len(drafts) <= retention.(int) {
+		return
+	}
+
+	for _, number := range drafts[retention.(int):] {
+		log.Printf("[INFO] Pruning draft version (%d) of Fastly Service (%s)", number, d.Id())
+		// The API client has no DeleteVersion method, so issue the request
+		// directly.
+		resp, err := conn.Delete(fmt.Sprintf("/service/%s/version/%d", d.Id(), number), nil)
+		if err != nil {
+			log.Printf("[WARN] Error pruning draft version (%d) of Fastly Service (%s): %s", number, d.Id(), err)
+			continue
+		}
+		resp.Body.Close()
+	}
+}
+
+// rollbackOnFailure re-activates the previously active version when a step
+// after activation fails and `auto_rollback` is enabled. The original error is
+// always returned so the apply still fails.
+func rollbackOnFailure(d *schema.ResourceData, conn *gofastly.Client, previousActiveVersion int, cause error) error {
+	if !d.Get("auto_rollback").(bool) || previousActiveVersion == 0 {
+		return cause
+	}
+
+	log.Printf("[WARN] Rolling back Fastly Service (%s) to version (%d): %s", d.Id(), previousActiveVersion, cause)
+	if _, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
+		ServiceID:      d.Id(),
+		ServiceVersion: previousActiveVersion,
+	}); err != nil {
+		return fmt.Errorf("[ERR] Error rolling back to version (%d): %s (original error: %s)", previousActiveVersion, err, cause)
+	}
+
+	d.Set("active_version", previousActiveVersion)
+	return cause
+}
+
+// operationTimeout returns the timeout declared for the operation in flight,
+// honoring a user-supplied `timeouts` block. Create delegates to Update, so
+// the create timeout applies whenever the resource is new.
+func operationTimeout(d *schema.ResourceData) time.Duration {
+	if d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
+// runActivationGates evaluates the `activation_gate` probes against the newly
+// activated version, retrying each as configured before declaring the deploy
+// bad. Probing stops at the operation deadline even if retries remain, so the
+// gates cannot run an apply past its declared timeout.
+func runActivationGates(d *schema.ResourceData, deadline time.Time) error {
+	gates, ok := d.GetOk("activation_gate")
+	if !ok {
+		return nil
+	}
+
+	for _, raw := range gates.([]interface{}) {
+		gate := raw.(map[string]interface{})
+		url := gate["url"].(string)
+		expectedStatus := gate["expected_status"].(int)
+		retries := gate["retries"].(int)
+		retryDelay := time.Duration(gate["retry_delay_seconds"].(int)) * time.Second
+		client := &http.Client{Timeout: time.Duration(gate["timeout_seconds"].(int)) * time.Second}
+
+		var lastErr error
+		for attempt := 0; attempt <= retries; attempt++ {
+			if attempt > 0 {
+				if time.Now().Add(retryDelay).After(deadline) {
+					lastErr = fmt.Errorf("operation timeout reached after %s", lastErr)
+					break
+				}
+				time.Sleep(retryDelay)
+			}
+
+			log.Printf("[DEBUG] Probing activation gate %s (attempt %d/%d)", url, attempt+1, retries+1)
+			resp, err := client.Get(url)
 			if err != nil {
-				return fmt.Errorf("[ERR] Error activating version (%d): %s", latestVersion, err)
+				lastErr = err
+				continue
 			}
+			resp.Body.Close()
+
+			if resp.StatusCode == expectedStatus {
+				lastErr = nil
+				break
+			}
+			lastErr = fmt.Errorf("got status %d, want %d", resp.StatusCode, expectedStatus)
+		}
 
-			// Only if the version is valid and activated do we set the active_version.
-			// This prevents us from getting stuck in cloning an invalid version.
-			d.Set("active_version", latestVersion)
-		} else {
-			log.Printf("[INFO] Skipping activation of Fastly Service (%s), Version (%v)", d.Id(), latestVersion)
-			log.Print("[INFO] The Terraform definition is explicitly specified to not activate the changes on Fastly")
-			log.Printf("[INFO] Version (%v) has been pushed and validated", latestVersion)
-			log.Printf("[INFO] Visit https://manage.fastly.com/configure/services/%s/versions/%v and activate it manually", d.Id(), latestVersion)
+		if lastErr != nil {
+			return fmt.Errorf("[ERR] Activation gate %s failed after %d attempts: %s", url, retries+1, lastErr)
 		}
 	}
 
-	return resourceServiceRead(d, meta, serviceDef, false)
+	return nil
+}
+
+// purgeAfterActivation issues any purges requested via `purge_all_on_activation`
+// or `purge_keys` once a new version has been activated.
+func purgeAfterActivation(d *schema.ResourceData, conn *gofastly.Client) error {
+	if d.Get("purge_all_on_activation").(bool) {
+		log.Printf("[DEBUG] Purging all content for Fastly Service (%s)", d.Id())
+		if _, err := conn.PurgeAll(&gofastly.PurgeAllInput{
+			ServiceID: d.Id(),
+		}); err != nil {
+			return fmt.Errorf("[ERR] Error purging all content for service (%s): %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	if keys, ok := d.GetOk("purge_keys"); ok {
+		for _, k := range keys.(*schema.Set).List() {
+			key := k.(string)
+			log.Printf("[DEBUG] Purging key (%s) for Fastly Service (%s)", key, d.Id())
+			if _, err := conn.PurgeKey(&gofastly.PurgeKeyInput{
+				ServiceID: d.Id(),
+				Key:       key,
+			}); err != nil {
+				return fmt.Errorf("[ERR] Error purging key (%s) for service (%s): %s", key, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
 }
 
 // resourceServiceRead provides service resource Read functionality.
 func resourceServiceRead(d *schema.ResourceData, meta interface{}, serviceDef ServiceDefinition, isImport bool) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
-	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
-		ID: d.Id(),
-	})
+	s, err := client.serviceDetails.Get(conn, d.Id())
 	if err != nil {
 		// Check if not found, if so, clear ID field and exit early.
 		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
@@ -445,31 +1003,71 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}, serviceDef Se
 
 	// Check for service type mismatch (i.e. when importing)
 	if s.Type != serviceDef.GetType() {
-		return fmt.Errorf("[ERR] Service type mismatch in READ, expected: %s, got: %s", serviceDef.GetType(), s.Type)
+		return fmt.Errorf("[ERR] Service %s is a %s service; manage it with a %s resource instead", d.Id(), serviceTypeLabel(s.Type), serviceResourceForType(s.Type))
 	}
 
 	d.Set("name", s.Name)
 	d.Set("comment", s.Comment)
-	d.Set("version_comment", s.Version.Comment)
+	// A templated comment is stamped by the provider rather than configured,
+	// so reading it back would diff against the (empty) version_comment.
+	if d.Get("version_comment_template").(string) == "" {
+		d.Set("version_comment", s.Version.Comment)
+	}
 	d.Set("active_version", s.ActiveVersion.Number)
 
-	// If we are importing or `activate` is set to false, temporarily set the
-	// service.ActiveVersion number to the latest version supplied via the get
-	// service version details call. This is to ensure we still read all of the
-	// state below.
+	// Version bookkeeping for pipelines that record what an apply produced.
+	// A staged version is a draft newer than the active version, e.g. from
+	// `activate = false`; zero means there is none.
+	if !s.Version.Active && s.Version.Number != s.ActiveVersion.Number {
+		d.Set("staged_version", s.Version.Number)
+	} else {
+		d.Set("staged_version", 0)
+	}
+	if s.ActiveVersion.CreatedAt != nil {
+		d.Set("active_version_created_at", s.ActiveVersion.CreatedAt.UTC().Format(time.RFC3339))
+	} else {
+		d.Set("active_version_created_at", "")
+	}
+	if s.UpdatedAt != nil {
+		d.Set("updated_at", s.UpdatedAt.UTC().Format(time.RFC3339))
+	}
+
+	// If there is no active version — the service was imported before being
+	// activated, was created with `activate = false`, or has never been
+	// activated — temporarily set the service.ActiveVersion number to the
+	// latest version supplied via the get service version details call. This is
+	// to ensure we still read all of the state below rather than refreshing
+	// against nothing and producing spurious diffs.
+	// `read_latest_version` opts a service into tracking the latest version
+	// even when an active version exists.
 	isInactive := d.Get("activate").(bool) == false
-	if s.ActiveVersion.Number == 0 && isImport || isInactive {
+	trackLatest := d.Get("read_latest_version").(bool)
+	if s.ActiveVersion.Number == 0 || isInactive || trackLatest {
 		s.ActiveVersion.Number = s.Version.Number
 	}
 
+	d.Set("read_version", s.ActiveVersion.Number)
+
 	// If CreateService succeeds, but initial updates to the Service fail, we'll
 	// have an empty ActiveService version (no version is active, so we can't
 	// query for information on it).
 	if s.ActiveVersion.Number != 0 {
 
 		// This delegates read to all the attribute handlers which can then manage reading state for
-		// their own attributes.
+		// their own attributes. Handlers run sequentially on purpose: several
+		// call d.Get as well as d.Set during their Read, and the SDK's
+		// ResourceData builds its readers over the raw writer map without
+		// taking the writer's lock, so a Get in one goroutine racing a Set in
+		// another is an unsynchronized map access. The skip logic below keeps
+		// refresh fast by not listing block types the service does not use.
 		for _, a := range serviceDef.GetAttributeHandler() {
+			// Skip refreshing block types the service does not use; listing
+			// every block type makes refresh needlessly slow on services with
+			// a handful of blocks. Imports still read everything.
+			if !isImport && (!isAttributeManaged(d, a) || canSkipAttributeRead(d, a)) {
+				continue
+			}
+
 			if err := a.Read(d, s, conn); err != nil {
 				return err
 			}
@@ -481,9 +1079,396 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}, serviceDef Se
 	return nil
 }
 
+// diagnoseVersionCloneCauses records, in the computed pending_version_changes
+// attribute and the logs, which block types in the current plan force the
+// provider to clone and activate a new service version. Versionless changes
+// (the service name and comment) never appear, so reviewers can tell a
+// metadata-only apply from one that ships a new version.
+func diagnoseVersionCloneCauses(serviceDef ServiceDefinition) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, v interface{}) error {
+		// Creation always builds version 1; there is nothing to diagnose.
+		if diff.Id() == "" {
+			return nil
+		}
+
+		var causes []string
+		for _, a := range serviceDef.GetAttributeHandler() {
+			ka, ok := a.(interface{ GetKey() string })
+			if !ok || ka.GetKey() == "" {
+				continue
+			}
+			if diff.HasChange(ka.GetKey()) {
+				causes = append(causes, ka.GetKey())
+			}
+		}
+		// The settings handler has no block key of its own.
+		for _, key := range []string{"default_ttl", "default_host"} {
+			if diff.HasChange(key) {
+				causes = append(causes, key)
+			}
+		}
+		sort.Strings(causes)
+
+		if len(causes) == 0 {
+			return nil
+		}
+
+		log.Printf("[INFO] Fastly Service (%s): changes to %s require cloning and activating a new version", diff.Id(), strings.Join(causes, ", "))
+		return diff.SetNew("pending_version_changes", causes)
+	}
+}
+
+// validateComputePackage requires `activate = false` while a Compute service
+// has no package block. The API refuses to activate a wasm service version
+// without a package, so catching it at plan time avoids failing mid-apply;
+// omitting the package lets the service be bootstrapped so CI can deploy the
+// first real package against the exposed service ID.
+func validateComputePackage(serviceDef ServiceDefinition) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, v interface{}) error {
+		if serviceDef.GetType() != ServiceTypeCompute {
+			return nil
+		}
+		if pkg, ok := diff.Get("package").([]interface{}); ok && len(pkg) > 0 {
+			return nil
+		}
+		if diff.Get("activate").(bool) {
+			return fmt.Errorf("a Compute service without a package cannot be activated: add a package block, or set activate = false until the first package is deployed")
+		}
+		return nil
+	}
+}
+
+// validateUniqueNestedNames returns a CustomizeDiff function that rejects
+// duplicate `name` values within each nested block type. Nested blocks are
+// identified by name when diffing against the API, so two blocks of the same
+// type sharing a name either clobber each other silently or surface as a
+// confusing API error mid-apply.
+func validateUniqueNestedNames(serviceDef ServiceDefinition) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, v interface{}) error {
+		for _, a := range serviceDef.GetAttributeHandler() {
+			ka, ok := a.(interface{ GetKey() string })
+			if !ok || ka.GetKey() == "" {
+				continue
+			}
+			key := ka.GetKey()
+
+			set, ok := diff.Get(key).(*schema.Set)
+			if !ok {
+				continue
+			}
+
+			if err := checkUniqueNestedNames(key, set.List()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// checkUniqueNestedNames rejects duplicate `name` values among one block
+// type's elements. It relies on the set keeping same-named blocks as distinct
+// elements, which holds under the default content-based set hash.
+func checkUniqueNestedNames(key string, entries []interface{}) error {
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			continue
+		}
+		if seen[name] {
+			return fmt.Errorf("multiple %s blocks with name %q: names must be unique within a block type", key, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// validateHealthcheckConstraints mirrors the API's cross-field constraints on
+// healthcheck blocks, so violations fail during plan with a clear error
+// instead of mid-apply after a version has been cloned.
+func validateHealthcheckConstraints(diff *schema.ResourceDiff, v interface{}) error {
+	set, ok := diff.Get("healthcheck").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, e := range set.List() {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := m["name"].(string)
+
+		timeout := m["timeout"].(int)
+		checkInterval := m["check_interval"].(int)
+		threshold := m["threshold"].(int)
+		window := m["window"].(int)
+		initial := m["initial"].(int)
+
+		if timeout >= checkInterval {
+			return fmt.Errorf("healthcheck %q: timeout (%d) must be less than check_interval (%d)", name, timeout, checkInterval)
+		}
+		if threshold > window {
+			return fmt.Errorf("healthcheck %q: threshold (%d) cannot exceed window (%d)", name, threshold, window)
+		}
+		if initial > threshold {
+			return fmt.Errorf("healthcheck %q: initial (%d) cannot exceed threshold (%d)", name, initial, threshold)
+		}
+	}
+	return nil
+}
+
+// validateHeaderPriorityConflicts rejects two header blocks that act on the
+// same type and destination with the same priority. Fastly applies headers in
+// priority order, so ties between headers rewriting the same destination are
+// resolved in an undefined order and the winner can change between versions.
+func validateHeaderPriorityConflicts(diff *schema.ResourceDiff, v interface{}) error {
+	set, ok := diff.Get("header").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	seen := make(map[string]string)
+	for _, e := range set.List() {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := m["name"].(string)
+		key := fmt.Sprintf("%s/%s/%d", m["type"].(string), m["destination"].(string), m["priority"].(int))
+
+		if other, ok := seen[key]; ok {
+			return fmt.Errorf("header blocks %q and %q both act on %s header %q with priority %d: their order of execution is undefined, give them distinct priorities", other, name, m["type"], m["destination"], m["priority"])
+		}
+		seen[key] = name
+	}
+	return nil
+}
+
+// validateResponseObjectContent rejects a response_object block that sets both
+// `content` and `content_file`; ConflictsWith cannot express this inside a
+// nested set, so it is enforced here.
+func validateResponseObjectContent(diff *schema.ResourceDiff, v interface{}) error {
+	set, ok := diff.Get("response_object").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, e := range set.List() {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if m["content"].(string) != "" && m["content_file"].(string) != "" {
+			return fmt.Errorf("response_object %q: content and content_file are mutually exclusive", m["name"])
+		}
+	}
+	return nil
+}
+
+// validateBackendSecretFiles rejects a backend block that sets both the inline
+// form and the `*_file` form of a PEM attribute; ConflictsWith cannot express
+// this inside a nested set, so it is enforced here.
+func validateBackendSecretFiles(diff *schema.ResourceDiff, v interface{}) error {
+	set, ok := diff.Get("backend").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	for _, e := range set.List() {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, k := range backendFileBackedSecrets {
+			file, _ := m[k+"_file"].(string)
+			if file != "" && m[k].(string) != "" {
+				return fmt.Errorf("backend %q: %s and %s_file are mutually exclusive", m["name"], k, k)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSingleMainVCL checks that when custom VCL is supplied, exactly one
+// vcl block is marked `main = true`. The API only enforces this at activation,
+// after a version has already been cloned and uploaded.
+func validateSingleMainVCL(diff *schema.ResourceDiff, v interface{}) error {
+	set, ok := diff.Get("vcl").(*schema.Set)
+	if !ok || set.Len() == 0 {
+		return nil
+	}
+
+	var mains []string
+	for _, e := range set.List() {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if m["main"].(bool) {
+			mains = append(mains, m["name"].(string))
+		}
+	}
+
+	switch len(mains) {
+	case 0:
+		return fmt.Errorf("one vcl block must set `main = true` when custom VCL is used")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("only one vcl block can set `main = true`, found %d: %s", len(mains), strings.Join(mains, ", "))
+	}
+}
+
+// nestedBlockNames collects the `name` values of a nested block type from the
+// diff, returning ok=false when the block type does not exist on this resource.
+func nestedBlockNames(diff *schema.ResourceDiff, key string) (map[string]bool, bool) {
+	set, ok := diff.Get(key).(*schema.Set)
+	if !ok {
+		return nil, false
+	}
+	names := make(map[string]bool)
+	for _, e := range set.List() {
+		if m, ok := e.(map[string]interface{}); ok {
+			if name, ok := m["name"].(string); ok && name != "" {
+				names[name] = true
+			}
+		}
+	}
+	return names, true
+}
+
+// validateCrossBlockReferences checks that nested blocks referencing other
+// blocks by name (backends to healthchecks and conditions, directors to
+// backends, request and cache settings to conditions) point at blocks that
+// exist, failing the plan with a precise message instead of an activation
+// error after the version has been uploaded.
+//
+// Each check only applies when at least one block of the referenced type is
+// configured: a service defining none may legitimately reference entities
+// managed outside this resource (e.g. a fastly_service_backend resource).
+func validateCrossBlockReferences(diff *schema.ResourceDiff, v interface{}) error {
+	conditions, _ := nestedBlockNames(diff, "condition")
+	backends, _ := nestedBlockNames(diff, "backend")
+	healthchecks, _ := nestedBlockNames(diff, "healthcheck")
+
+	checkRef := func(blockType, blockName, attr, target string, known map[string]bool, targetType string) error {
+		if target == "" || len(known) == 0 || known[target] {
+			return nil
+		}
+		return fmt.Errorf("%s %q: %s references %s %q, which is not defined", blockType, blockName, attr, targetType, target)
+	}
+
+	if set, ok := diff.Get("backend").(*schema.Set); ok {
+		for _, e := range set.List() {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := m["name"].(string)
+			if hc, ok := m["healthcheck"].(string); ok {
+				if err := checkRef("backend", name, "healthcheck", hc, healthchecks, "healthcheck"); err != nil {
+					return err
+				}
+			}
+			if rc, ok := m["request_condition"].(string); ok {
+				if err := checkRef("backend", name, "request_condition", rc, conditions, "condition"); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if set, ok := diff.Get("director").(*schema.Set); ok {
+		for _, e := range set.List() {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name := m["name"].(string)
+			if bs, ok := m["backends"].(*schema.Set); ok {
+				for _, b := range bs.List() {
+					if err := checkRef("director", name, "backends", b.(string), backends, "backend"); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+
+	for blockType, attr := range map[string]string{
+		"request_setting": "request_condition",
+		"cache_setting":   "cache_condition",
+	} {
+		set, ok := diff.Get(blockType).(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, e := range set.List() {
+			m, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := checkRef(blockType, m["name"].(string), attr, m[attr].(string), conditions, "condition"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isAttributeManaged reports whether a handler's block type is included in
+// the `managed_block_types` allow-list. An empty list means every block type
+// is managed, and handlers without a single schema key (such as the settings
+// handler) are always managed.
+func isAttributeManaged(d *schema.ResourceData, a ServiceAttributeDefinition) bool {
+	v, ok := d.GetOk("managed_block_types")
+	if !ok {
+		return true
+	}
+
+	ka, ok := a.(interface{ GetKey() string })
+	if !ok || ka.GetKey() == "" {
+		return true
+	}
+
+	return v.(*schema.Set).Contains(ka.GetKey())
+}
+
+// canSkipAttributeRead reports whether an attribute handler's refresh can be
+// skipped because the service has no blocks of that type in state or
+// configuration. Handlers that do not expose a single schema key (such as the
+// settings handler) are always read.
+func canSkipAttributeRead(d *schema.ResourceData, a ServiceAttributeDefinition) bool {
+	ka, ok := a.(interface{ GetKey() string })
+	if !ok || ka.GetKey() == "" {
+		return false
+	}
+
+	_, exists := d.GetOk(ka.GetKey())
+	return !exists
+}
+
 // resourceServiceDelete provides service resource Delete functionality.
 func resourceServiceDelete(d *schema.ResourceData, meta interface{}, serviceDef ServiceDefinition) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	// deletion_protection is checked from state, not configuration, so a
+	// rename or removal of the resource block cannot bypass it; it has to be
+	// disabled in an applied configuration first.
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("[ERR] Fastly Service (%s) has deletion_protection enabled and cannot be deleted; apply a configuration with deletion_protection = false first", d.Id())
+	}
+
+	// Serialize against other resources operating on the same service.
+	client.serviceLocks.Lock(d.Id())
+	defer client.serviceLocks.Unlock(d.Id())
 
 	// Fastly will fail to delete any service with an Active Version.
 	// If `force_destroy` is given, we deactivate the active version and then send
@@ -508,6 +1493,8 @@ func resourceServiceDelete(d *schema.ResourceData, meta interface{}, serviceDef
 		}
 	}
 
+	client.serviceDetails.Invalidate(d.Id())
+
 	return conn.DeleteService(&gofastly.DeleteServiceInput{
 		ID: d.Id(),
 	})
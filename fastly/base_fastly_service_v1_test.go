@@ -0,0 +1,72 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// TestNestedBlockSetKeepsDuplicateNames guards the hashing behavior
+// checkUniqueNestedNames depends on: under the default content-based set
+// hash, two blocks sharing a name but differing elsewhere must stay distinct
+// set elements, so the validator can see and reject them. A name-keyed hash
+// would collapse them into one before any validation runs.
+func TestNestedBlockSetKeepsDuplicateNames(t *testing.T) {
+	backendSchema := resourceServiceV1().Schema["backend"]
+	if backendSchema.Set != nil {
+		t.Fatal("expected the backend set to use the default content-based hash")
+	}
+
+	set := schema.NewSet(schema.HashResource(backendSchema.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"name": "origin", "address": "a.example.com"},
+		map[string]interface{}{"name": "origin", "address": "b.example.com"},
+	})
+
+	if set.Len() != 2 {
+		t.Fatalf("expected both same-named backends to remain in the set, got %d elements", set.Len())
+	}
+}
+
+func TestCheckUniqueNestedNames(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		entries   []interface{}
+		wantError bool
+	}{
+		"unique names": {
+			entries: []interface{}{
+				map[string]interface{}{"name": "origin", "address": "a.example.com"},
+				map[string]interface{}{"name": "fallback", "address": "b.example.com"},
+			},
+		},
+		"duplicate names": {
+			entries: []interface{}{
+				map[string]interface{}{"name": "origin", "address": "a.example.com"},
+				map[string]interface{}{"name": "origin", "address": "b.example.com"},
+			},
+			wantError: true,
+		},
+		"empty names skipped": {
+			entries: []interface{}{
+				map[string]interface{}{"name": ""},
+				map[string]interface{}{"name": ""},
+			},
+		},
+		"no entries": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkUniqueNestedNames("backend", testCase.entries)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				if !strings.Contains(err.Error(), `backend blocks with name "origin"`) {
+					t.Errorf("expected the error to name the block type and name, got %q", err)
+				}
+			} else if err != nil {
+				t.Errorf("expected no error, got %q", err)
+			}
+		})
+	}
+}
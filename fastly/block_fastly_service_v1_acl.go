@@ -133,7 +133,15 @@ func (h *ACLServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 	}
 
 	if err := d.Set(h.GetKey(), al); err != nil {
-		log.Printf("[WARN] Error setting ACLs for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting ACLs for (%s): %s", d.Id(), err)
+	}
+
+	aclIDs := make(map[string]interface{}, len(aclList))
+	for _, acl := range aclList {
+		aclIDs[acl.Name] = acl.ID
+	}
+	if err := d.Set("acl_ids", aclIDs); err != nil {
+		return fmt.Errorf("[ERR] Error setting ACL IDs for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -166,6 +174,16 @@ func (h *ACLServiceAttributeHandler) Register(s *schema.Resource) error {
 			},
 		},
 	}
+
+	// A flat name → ID map alongside the block set, so standalone entry
+	// resources and external tooling can reference ACL IDs without a
+	// data-source round-trip.
+	s.Schema["acl_ids"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A map of ACL name to ACL ID for the ACLs on the service",
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 type BackendServiceAttributeHandler struct {
@@ -65,14 +66,28 @@ func (h *BackendServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 
 	// CREATE new resources
 	for _, resource := range diffResult.Added {
-		resource := resource.(map[string]interface{})
+		resource, err := resolveBackendSecretFiles(resource.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
 		opts := h.buildCreateBackendInput(d.Id(), latestVersion, resource)
 
 		log.Printf("[DEBUG] Create Backend Opts: %#v", opts)
-		_, err := conn.CreateBackend(&opts)
+		_, err = conn.CreateBackend(&opts)
 		if err != nil {
 			return err
 		}
+
+		// Explicit zeroes are dropped from the create payload, letting the API
+		// apply its non-zero defaults; carry them over in a follow-up update.
+		zeroed := explicitZeroNumericFields(resource, "between_bytes_timeout", "connect_timeout", "first_byte_timeout")
+		if len(zeroed) > 0 {
+			updateOpts := h.buildUpdateBackendInput(d.Id(), latestVersion, resource, zeroed)
+			log.Printf("[DEBUG] Update Backend Opts (explicit zeroes): %#v", updateOpts)
+			if _, err := conn.UpdateBackend(&updateOpts); err != nil {
+				return err
+			}
+		}
 	}
 
 	// UPDATE modified resources
@@ -85,11 +100,30 @@ func (h *BackendServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
+
+		resolved, err := resolveBackendSecretFiles(resource)
+		if err != nil {
+			return err
+		}
+
+		// A change to a `*_file` path or `*_sha256` hash means the resolved
+		// PEM value must be sent, even though the inline attribute itself is
+		// unchanged.
+		for _, k := range backendFileBackedSecrets {
+			_, fileChanged := modified[k+"_file"]
+			_, hashChanged := modified[k+"_sha256"]
+			if fileChanged || hashChanged {
+				modified[k] = resolved[k]
+			}
+		}
 
-		opts := h.buildUpdateBackendInput(d.Id(), latestVersion, resource, modified)
+		opts := h.buildUpdateBackendInput(d.Id(), latestVersion, resolved, modified)
 
 		log.Printf("[DEBUG] Update Backend Opts: %#v", opts)
-		_, err := conn.UpdateBackend(&opts)
+		_, err = conn.UpdateBackend(&opts)
 		if err != nil {
 			return err
 		}
@@ -247,8 +281,40 @@ func (h *BackendServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastl
 	}
 
 	bl := flattenBackend(backendList, h.GetServiceMetadata())
+
+	// Backends configured from local files keep only a hash of the PEM
+	// material in state: restore the `*_file` attributes from the prior state
+	// and replace what the API returned with its SHA-256.
+	if v, ok := d.GetOk(h.GetKey()); ok {
+		prior := map[string]map[string]interface{}{}
+		for _, raw := range v.(*schema.Set).List() {
+			m := raw.(map[string]interface{})
+			prior[m["name"].(string)] = m
+		}
+		for _, backend := range bl {
+			p, ok := prior[backend["name"].(string)]
+			if !ok {
+				continue
+			}
+			for _, k := range backendFileBackedSecrets {
+				file, _ := p[k+"_file"].(string)
+				if file == "" {
+					continue
+				}
+				backend[k+"_file"] = file
+				// The hash attribute only participates when the user set it
+				// (to filesha256 of the file), so leaving it empty does not
+				// produce a perpetual diff.
+				if hash, _ := p[k+"_sha256"].(string); hash != "" {
+					backend[k+"_sha256"] = secretSHA256(backend[k].(string))
+				}
+				backend[k] = ""
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), bl); err != nil {
-		log.Printf("[WARN] Error setting Backends for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Backends for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -274,28 +340,32 @@ func (h *BackendServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "Denotes if this Backend should be included in the pool of backends that requests are load balanced against. Default `true`",
 		},
 		"between_bytes_timeout": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     10000,
-			Description: "How long to wait between bytes in milliseconds. Default `10000`",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10000,
+			Description:  "How long to wait between bytes in milliseconds. Default `10000`",
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"connect_timeout": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     1000,
-			Description: "How long to wait for a timeout in milliseconds. Default `1000`",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1000,
+			Description:  "How long to wait for a timeout in milliseconds. Default `1000`",
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"error_threshold": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: "Number of errors to allow before the Backend is marked as down. Default `0`",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			Description:  "Number of errors to allow before the Backend is marked as down. Default `0`",
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"first_byte_timeout": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     15000,
-			Description: "How long to wait for the first bytes in milliseconds. Default `15000`",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      15000,
+			Description:  "How long to wait for the first bytes in milliseconds. Default `15000`",
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"healthcheck": {
 			Type:        schema.TypeString,
@@ -304,10 +374,11 @@ func (h *BackendServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "Name of a defined `healthcheck` to assign to this backend",
 		},
 		"max_conn": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     200,
-			Description: "Maximum number of connections for this Backend. Default `200`",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      200,
+			Description:  "Maximum number of connections for this Backend. Default `200`",
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"port": {
 			Type:        schema.TypeInt,
@@ -364,10 +435,23 @@ func (h *BackendServiceAttributeHandler) Register(s *schema.Resource) error {
 			Deprecated:  "Use ssl_cert_hostname and ssl_sni_hostname instead.",
 		},
 		"ssl_ca_cert": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "",
+			Description:  "CA certificate attached to origin.",
+			ValidateFunc: validatePEMCertificates(),
+		},
+		"ssl_ca_cert_file": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Default:     "",
-			Description: "CA certificate attached to origin.",
+			Description: "Path to a local file containing the CA certificate, read at apply time. Only a SHA-256 of the content is kept in state. Conflicts with `ssl_ca_cert`",
+		},
+		"ssl_ca_cert_sha256": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Used to trigger updates when `ssl_ca_cert_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"ca.pem\")`",
 		},
 		"ssl_cert_hostname": {
 			Type:        schema.TypeString,
@@ -382,24 +466,51 @@ func (h *BackendServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "Overrides ssl_hostname, but only for SNI in the handshake. Does not affect cert validation at all",
 		},
 		"ssl_client_cert": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "",
+			Description:  "Client certificate attached to origin. Used when connecting to the backend",
+			Sensitive:    true,
+			ValidateFunc: validatePEMCertificates(),
+		},
+		"ssl_client_cert_file": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Default:     "",
-			Description: "Client certificate attached to origin. Used when connecting to the backend",
-			Sensitive:   true,
+			Description: "Path to a local file containing the client certificate, read at apply time. Only a SHA-256 of the content is kept in state. Conflicts with `ssl_client_cert`",
+		},
+		"ssl_client_cert_sha256": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Used to trigger updates when `ssl_client_cert_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"client.pem\")`",
 		},
 		"ssl_client_key": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "",
+			Description:  "Client key attached to origin. Used when connecting to the backend",
+			Sensitive:    true,
+			ValidateFunc: validatePEMPrivateKey(),
+		},
+		"ssl_client_key_file": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Default:     "",
-			Description: "Client key attached to origin. Used when connecting to the backend",
-			Sensitive:   true,
+			Description: "Path to a local file containing the client key, read at apply time, for policies that forbid PEM material in state files. Only a SHA-256 of the content is kept in state. Conflicts with `ssl_client_key`",
 		},
-		"weight": {
-			Type:        schema.TypeInt,
+		"ssl_client_key_sha256": {
+			Type:        schema.TypeString,
 			Optional:    true,
-			Default:     100,
-			Description: "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Default `100`",
+			Computed:    true,
+			Description: "Used to trigger updates when `ssl_client_key_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"client.key\")`",
+		},
+		"weight": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 100),
+			Description:  "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Default `100`",
 		},
 	}
 
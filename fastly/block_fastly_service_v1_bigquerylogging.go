@@ -6,6 +6,7 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 type BigQueryLoggingServiceAttributeHandler struct {
@@ -126,6 +127,9 @@ func (h *BigQueryLoggingServiceAttributeHandler) Process(d *schema.ResourceData,
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -186,7 +190,7 @@ func (h *BigQueryLoggingServiceAttributeHandler) Read(d *schema.ResourceData, s
 
 	bql := flattenBigQuery(BQList)
 	if err := d.Set(h.GetKey(), bql); err != nil {
-		log.Printf("[WARN] Error setting bigquerylogging for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting bigquerylogging for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -242,10 +246,12 @@ func (h *BigQueryLoggingServiceAttributeHandler) Register(s *schema.Resource) er
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The logging format desired.",
-			Default:     "%h %l %u %t \"%r\" %>s %b",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "The logging format desired.",
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validation.All(validateLogFormat(), validateLoggingJSONFormat()),
 		}
 		blockAttributes["response_condition"] = &schema.Schema{
 			Type:        schema.TypeString,
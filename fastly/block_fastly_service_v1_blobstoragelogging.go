@@ -127,6 +127,9 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Process(d *schema.ResourceDa
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -200,7 +203,7 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Read(d *schema.ResourceData,
 	bsl := flattenBlobStorages(blobStorageList)
 
 	if err := d.Set(h.GetKey(), bsl); err != nil {
-		log.Printf("[WARN] Error setting Blob Storages for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Blob Storages for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -272,10 +275,12 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Register(s *schema.Resource)
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -100,6 +100,9 @@ func (h *CacheSettingServiceAttributeHandler) Process(d *schema.ResourceData, la
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -142,7 +145,7 @@ func (h *CacheSettingServiceAttributeHandler) Read(d *schema.ResourceData, s *go
 	csl := flattenCacheSettings(cslList)
 
 	if err := d.Set(h.GetKey(), csl); err != nil {
-		log.Printf("[WARN] Error setting Cache Settings for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Cache Settings for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
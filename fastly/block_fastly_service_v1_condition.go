@@ -110,6 +110,9 @@ func (h *ConditionServiceAttributeHandler) Process(d *schema.ResourceData, lates
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -153,7 +156,7 @@ func (h *ConditionServiceAttributeHandler) Read(d *schema.ResourceData, s *gofas
 	cl := flattenConditions(conditionList)
 
 	if err := d.Set(h.GetKey(), cl); err != nil {
-		log.Printf("[WARN] Error setting Conditions for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Conditions for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -137,7 +137,15 @@ func (h *DictionaryServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 	}
 
 	if err := d.Set(h.GetKey(), dictionaries); err != nil {
-		log.Printf("[WARN] Error setting Dictionary for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Dictionary for (%s): %s", d.Id(), err)
+	}
+
+	dictionaryIDs := make(map[string]interface{}, len(dictList))
+	for _, dict := range dictList {
+		dictionaryIDs[dict.Name] = dict.ID
+	}
+	if err := d.Set("dictionary_ids", dictionaryIDs); err != nil {
+		return fmt.Errorf("[ERR] Error setting Dictionary IDs for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -175,6 +183,16 @@ func (h *DictionaryServiceAttributeHandler) Register(s *schema.Resource) error {
 			},
 		},
 	}
+
+	// A flat name → ID map alongside the block set, so standalone item
+	// resources and external tooling can reference dictionary IDs without a
+	// data-source round-trip.
+	s.Schema["dictionary_ids"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A map of dictionary name to dictionary ID for the dictionaries on the service",
+	}
 	return nil
 }
 
@@ -135,6 +135,9 @@ func (h *DirectorServiceAttributeHandler) Process(d *schema.ResourceData, latest
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -245,7 +248,7 @@ func (h *DirectorServiceAttributeHandler) Read(d *schema.ResourceData, s *gofast
 	dirl := flattenDirectors(directorList, directorBackendList)
 
 	if err := d.Set(h.GetKey(), dirl); err != nil {
-		log.Printf("[WARN] Error setting Directors for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Directors for (%s): %s", d.Id(), err)
 	}
 
 	return nil
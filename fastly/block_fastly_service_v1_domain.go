@@ -3,6 +3,9 @@ package fastly
 import (
 	"fmt"
 	"log"
+	"strings"
+
+	"golang.org/x/net/idna"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -69,6 +72,14 @@ func (h *DomainServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 	// CREATE new resources
 	for _, resource := range diffResult.Added {
 		resource := resource.(map[string]interface{})
+
+		// Guard against the defunct diff entries a StateFunc in a nested
+		// TypeSet can produce - see
+		// https://github.com/hashicorp/terraform-plugin-sdk/issues/160#issuecomment-522935697
+		if v, ok := resource["name"]; !ok || v.(string) == "" {
+			continue
+		}
+
 		opts := gofastly.CreateDomainInput{
 			ServiceID:      d.Id(),
 			ServiceVersion: latestVersion,
@@ -80,7 +91,10 @@ func (h *DomainServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		}
 
 		log.Printf("[DEBUG] Fastly Domain Addition opts: %#v", opts)
-		_, err := conn.CreateDomain(&opts)
+		err := retryDomainConflict(d.Get("allow_domain_takeover").(bool), domainTakeoverTimeout, fmt.Sprintf("Adding domain (%s)", opts.Name), func() error {
+			_, err := conn.CreateDomain(&opts)
+			return err
+		})
 		if err != nil {
 			return err
 		}
@@ -102,6 +116,9 @@ func (h *DomainServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		if v, ok := modified["comment"]; ok {
 			opts.Comment = gofastly.String(v.(string))
@@ -135,7 +152,7 @@ func (h *DomainServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly
 	dl := flattenDomains(domainList)
 
 	if err := d.Set(h.GetKey(), dl); err != nil {
-		log.Printf("[WARN] Error setting Domains for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Domains for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -151,6 +168,12 @@ func (h *DomainServiceAttributeHandler) Register(s *schema.Resource) error {
 					Type:        schema.TypeString,
 					Required:    true,
 					Description: "The domain that this Service will respond to. It is important to note that changing this attribute will delete and recreate the resource.",
+					// Normalize so differences in case, a trailing dot or an
+					// unencoded IDN do not diff against the canonical form the
+					// API stores, which made imported services show permanent
+					// domain changes.
+					StateFunc:    domainNameStateFunc,
+					ValidateFunc: validateDomainName(),
 				},
 
 				"comment": {
@@ -169,10 +192,25 @@ func flattenDomains(list []*gofastly.Domain) []map[string]interface{} {
 
 	for _, d := range list {
 		dl = append(dl, map[string]interface{}{
-			"name":    d.Name,
+			"name":    normalizeDomainName(d.Name),
 			"comment": d.Comment,
 		})
 	}
 
 	return dl
 }
+
+// normalizeDomainName converts a domain to the canonical form the Fastly API
+// stores: lowercase, without a trailing dot, and with internationalized names
+// encoded as punycode.
+func normalizeDomainName(name string) string {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+	if ascii, err := idna.ToASCII(name); err == nil {
+		name = ascii
+	}
+	return name
+}
+
+func domainNameStateFunc(v interface{}) string {
+	return normalizeDomainName(v.(string))
+}
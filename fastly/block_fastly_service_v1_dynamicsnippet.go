@@ -102,6 +102,9 @@ func (h *DynamicSnippetServiceAttributeHandler) Process(d *schema.ResourceData,
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -143,7 +146,18 @@ func (h *DynamicSnippetServiceAttributeHandler) Read(d *schema.ResourceData, s *
 
 	dynamicSnippets := flattenDynamicSnippets(snippetList)
 	if err := d.Set(h.GetKey(), dynamicSnippets); err != nil {
-		log.Printf("[WARN] Error setting VCL Dynamic Snippets for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting VCL Dynamic Snippets for (%s): %s", d.Id(), err)
+	}
+
+	snippetIDs := make(map[string]interface{})
+	for _, snippet := range snippetList {
+		if snippet.Dynamic == 0 {
+			continue
+		}
+		snippetIDs[snippet.Name] = snippet.ID
+	}
+	if err := d.Set("dynamic_snippet_ids", snippetIDs); err != nil {
+		return fmt.Errorf("[ERR] Error setting VCL Dynamic Snippet IDs for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -180,6 +194,16 @@ func (h *DynamicSnippetServiceAttributeHandler) Register(s *schema.Resource) err
 			},
 		},
 	}
+
+	// A flat name → ID map alongside the block set, so standalone content
+	// resources and external tooling can reference snippet IDs without a
+	// data-source round-trip.
+	s.Schema["dynamic_snippet_ids"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "A map of dynamic snippet name to snippet ID for the dynamic snippets on the service",
+	}
 	return nil
 }
 
@@ -110,6 +110,9 @@ func (h *GCSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, late
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -179,7 +182,7 @@ func (h *GCSLoggingServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 
 	gcsl := flattenGCS(GCSList)
 	if err := d.Set(h.GetKey(), gcsl); err != nil {
-		log.Printf("[WARN] Error setting gcs for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting gcs for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -246,10 +249,12 @@ func (h *GCSLoggingServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t %r %>s",
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t %r %>s",
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["response_condition"] = &schema.Schema{
 			Type:        schema.TypeString,
@@ -75,26 +75,8 @@ func (h *GzipServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 			ServiceVersion: latestVersion,
 			Name:           resource["name"].(string),
 			CacheCondition: resource["cache_condition"].(string),
-		}
-
-		if v, ok := resource["content_types"]; ok {
-			if len(v.(*schema.Set).List()) > 0 {
-				var cl []string
-				for _, c := range v.(*schema.Set).List() {
-					cl = append(cl, c.(string))
-				}
-				opts.ContentTypes = strings.Join(cl, " ")
-			}
-		}
-
-		if v, ok := resource["extensions"]; ok {
-			if len(v.(*schema.Set).List()) > 0 {
-				var el []string
-				for _, e := range v.(*schema.Set).List() {
-					el = append(el, e.(string))
-				}
-				opts.Extensions = strings.Join(el, " ")
-			}
+			ContentTypes:   gzipFieldValue(resource, "content_types", gzipDefaultContentTypes),
+			Extensions:     gzipFieldValue(resource, "extensions", gzipDefaultExtensions),
 		}
 
 		log.Printf("[DEBUG] Fastly Gzip Addition opts: %#v", opts)
@@ -120,17 +102,23 @@ func (h *GzipServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
-		// NOTE: where we transition between interface{} we lose the ability to
-		// infer the underlying type being either a uint vs an int. This
-		// materializes as a panic (yay) and so it's only at runtime we discover
-		// this and so we've updated the below code to convert the type asserted
-		// int into a uint before passing the value to gofastly.Uint().
-		if v, ok := modified["content_types"]; ok {
-			opts.ContentTypes = gofastly.String(v.(string))
+		// content_types and extensions are sets in the schema but single
+		// space-separated strings in the API, so recompute the full value
+		// from the resource (including any defaults the
+		// use_default_settings flag merges in) whenever either the lists
+		// or the flag changed.
+		_, contentTypesChanged := modified["content_types"]
+		_, extensionsChanged := modified["extensions"]
+		_, defaultsChanged := modified["use_default_settings"]
+		if contentTypesChanged || defaultsChanged {
+			opts.ContentTypes = gofastly.String(gzipFieldValue(resource, "content_types", gzipDefaultContentTypes))
 		}
-		if v, ok := modified["extensions"]; ok {
-			opts.Extensions = gofastly.String(v.(string))
+		if extensionsChanged || defaultsChanged {
+			opts.Extensions = gofastly.String(gzipFieldValue(resource, "extensions", gzipDefaultExtensions))
 		}
 		if v, ok := modified["cache_condition"]; ok {
 			opts.CacheCondition = gofastly.String(v.(string))
@@ -159,8 +147,28 @@ func (h *GzipServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.S
 
 	gl := flattenGzips(gzipsList)
 
+	// For blocks applied with use_default_settings, subtract the implicit
+	// default entries from the API's lists so state mirrors what the user
+	// wrote rather than the expanded form.
+	if v, ok := d.GetOk(h.GetKey()); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			m, ok := raw.(map[string]interface{})
+			if !ok || !m["use_default_settings"].(bool) {
+				continue
+			}
+			for _, ng := range gl {
+				if ng["name"] != m["name"] {
+					continue
+				}
+				ng["use_default_settings"] = true
+				gzipSubtractDefaults(ng, "content_types", gzipDefaultContentTypes)
+				gzipSubtractDefaults(ng, "extensions", gzipDefaultExtensions)
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), gl); err != nil {
-		log.Printf("[WARN] Error setting Gzips for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Gzips for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -183,13 +191,25 @@ func (h *GzipServiceAttributeHandler) Register(s *schema.Resource) error {
 					Type:        schema.TypeSet,
 					Optional:    true,
 					Description: "The content-type for each type of content you wish to have dynamically gzip'ed. Example: `[\"text/html\", \"text/css\"]`",
-					Elem:        &schema.Schema{Type: schema.TypeString},
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateGzipContentType(),
+					},
 				},
 				"extensions": {
 					Type:        schema.TypeSet,
 					Optional:    true,
 					Description: "File extensions for each file type to dynamically gzip. Example: `[\"css\", \"js\"]`",
-					Elem:        &schema.Schema{Type: schema.TypeString},
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateGzipExtension(),
+					},
+				},
+				"use_default_settings": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Include Fastly's recommended `extensions` and `content_types` lists in this gzip configuration, in addition to any listed explicitly. Default `false`",
 				},
 				"cache_condition": {
 					Type:        schema.TypeString,
@@ -203,6 +223,75 @@ func (h *GzipServiceAttributeHandler) Register(s *schema.Resource) error {
 	return nil
 }
 
+// Fastly's recommended gzip settings, as applied by the "Use default settings"
+// option in the web interface.
+var (
+	gzipDefaultExtensions = []string{
+		"css", "js", "html", "eot", "ico", "otf", "ttf", "json", "svg",
+	}
+	gzipDefaultContentTypes = []string{
+		"text/html",
+		"application/x-javascript",
+		"text/css",
+		"application/javascript",
+		"text/javascript",
+		"application/json",
+		"application/vnd.ms-fontobject",
+		"application/x-font-opentype",
+		"application/x-font-truetype",
+		"application/x-font-ttf",
+		"application/xml",
+		"font/eot",
+		"font/opentype",
+		"font/otf",
+		"image/svg+xml",
+		"image/vnd.microsoft.icon",
+		"text/plain",
+		"text/xml",
+	}
+)
+
+// gzipFieldValue builds the space-separated string the API expects for
+// `content_types` or `extensions`, prepending the Fastly defaults when the
+// block sets `use_default_settings`.
+func gzipFieldValue(resource map[string]interface{}, key string, defaults []string) string {
+	seen := make(map[string]bool)
+	var values []string
+
+	if useDefaults, ok := resource["use_default_settings"].(bool); ok && useDefaults {
+		for _, v := range defaults {
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+
+	if v, ok := resource[key]; ok {
+		for _, e := range v.(*schema.Set).List() {
+			if s := e.(string); !seen[s] {
+				seen[s] = true
+				values = append(values, s)
+			}
+		}
+	}
+
+	return strings.Join(values, " ")
+}
+
+// gzipSubtractDefaults removes the Fastly default entries from a flattened
+// gzip map's set, dropping the key entirely if nothing else remains.
+func gzipSubtractDefaults(ng map[string]interface{}, key string, defaults []string) {
+	set, ok := ng[key].(*schema.Set)
+	if !ok {
+		return
+	}
+	for _, v := range defaults {
+		set.Remove(v)
+	}
+	if set.Len() == 0 {
+		delete(ng, key)
+	}
+}
+
 func flattenGzips(gzipsList []*gofastly.Gzip) []map[string]interface{} {
 	var gl []map[string]interface{}
 	for _, g := range gzipsList {
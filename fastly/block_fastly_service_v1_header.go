@@ -100,6 +100,9 @@ func (h *HeaderServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -115,10 +118,10 @@ func (h *HeaderServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		if v, ok := modified["type"]; ok {
 			opts.Type = gofastly.PHeaderType(gofastly.HeaderType(v.(string)))
 		}
-		if v, ok := modified["dst"]; ok {
+		if v, ok := modified["destination"]; ok {
 			opts.Destination = gofastly.String(v.(string))
 		}
-		if v, ok := modified["src"]; ok {
+		if v, ok := modified["source"]; ok {
 			opts.Source = gofastly.String(v.(string))
 		}
 		if v, ok := modified["regex"]; ok {
@@ -164,7 +167,7 @@ func (h *HeaderServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly
 	hl := flattenHeaders(headerList)
 
 	if err := d.Set(h.GetKey(), hl); err != nil {
-		log.Printf("[WARN] Error setting Headers for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Headers for (%s): %s", d.Id(), err)
 	}
 
 	return nil
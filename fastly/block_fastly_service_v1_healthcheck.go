@@ -91,6 +91,40 @@ func (h *HealthCheckServiceAttributeHandler) Process(d *schema.ResourceData, lat
 		if err != nil {
 			return err
 		}
+
+		// Explicit zeroes are dropped from the create payload, letting the API
+		// apply its non-zero defaults; carry them over in a follow-up update.
+		zeroed := explicitZeroNumericFields(resource, "timeout", "check_interval", "expected_response", "window", "threshold", "initial")
+		if len(zeroed) > 0 {
+			updateOpts := gofastly.UpdateHealthCheckInput{
+				ServiceID:      d.Id(),
+				ServiceVersion: latestVersion,
+				Name:           resource["name"].(string),
+			}
+			if _, ok := zeroed["timeout"]; ok {
+				updateOpts.Timeout = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["check_interval"]; ok {
+				updateOpts.CheckInterval = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["expected_response"]; ok {
+				updateOpts.ExpectedResponse = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["window"]; ok {
+				updateOpts.Window = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["threshold"]; ok {
+				updateOpts.Threshold = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["initial"]; ok {
+				updateOpts.Initial = gofastly.Uint(0)
+			}
+
+			log.Printf("[DEBUG] Update Healthcheck Opts (explicit zeroes): %#v", updateOpts)
+			if _, err := conn.UpdateHealthCheck(&updateOpts); err != nil {
+				return err
+			}
+		}
 	}
 
 	// UPDATE modified resources
@@ -109,6 +143,9 @@ func (h *HealthCheckServiceAttributeHandler) Process(d *schema.ResourceData, lat
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -173,7 +210,7 @@ func (h *HealthCheckServiceAttributeHandler) Read(d *schema.ResourceData, s *gof
 	hcl := flattenHealthchecks(healthcheckList)
 
 	if err := d.Set(h.GetKey(), hcl); err != nil {
-		log.Printf("[WARN] Error setting Healthcheck for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Healthcheck for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -89,6 +89,9 @@ func (h *HTTPSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, la
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -172,7 +175,7 @@ func (h *HTTPSLoggingServiceAttributeHandler) Read(d *schema.ResourceData, s *go
 	hll := flattenHTTPS(httpsList)
 
 	if err := d.Set(h.GetKey(), hll); err != nil {
-		log.Printf("[WARN] Error setting HTTPS logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting HTTPS logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -247,7 +250,8 @@ func (h *HTTPSLoggingServiceAttributeHandler) Register(s *schema.Resource) error
 			Description: "A secure certificate to authenticate the server with. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_cert": {
@@ -256,7 +260,8 @@ func (h *HTTPSLoggingServiceAttributeHandler) Register(s *schema.Resource) error
 			Description: "The client certificate used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_key": {
@@ -265,7 +270,8 @@ func (h *HTTPSLoggingServiceAttributeHandler) Register(s *schema.Resource) error
 			Description: "The client private key used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMPrivateKey(),
 		},
 
 		"tls_hostname": {
@@ -285,9 +291,11 @@ func (h *HTTPSLoggingServiceAttributeHandler) Register(s *schema.Resource) error
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -107,6 +107,9 @@ func (h *LogentriesServiceAttributeHandler) Process(d *schema.ResourceData, late
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -162,7 +165,7 @@ func (h *LogentriesServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 	lel := flattenLogentries(logentriesList)
 
 	if err := d.Set(h.GetKey(), lel); err != nil {
-		log.Printf("[WARN] Error setting Logentries for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Logentries for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -180,6 +183,7 @@ func (h *LogentriesServiceAttributeHandler) Register(s *schema.Resource) error {
 			Type:        schema.TypeString,
 			Required:    true,
 			Description: "Use token based authentication (https://logentries.com/doc/input-token/)",
+			Sensitive:   true,
 		},
 		// Optional
 		"port": {
@@ -198,10 +202,12 @@ func (h *LogentriesServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t %r %>s",
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t %r %>s",
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
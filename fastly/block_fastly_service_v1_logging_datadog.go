@@ -6,6 +6,7 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 type DatadogServiceAttributeHandler struct {
@@ -88,6 +89,9 @@ func (h *DatadogServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -138,7 +142,7 @@ func (h *DatadogServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastl
 	dll := flattenDatadog(datadogList)
 
 	if err := d.Set(h.GetKey(), dll); err != nil {
-		log.Printf("[WARN] Error setting Datadog logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Datadog logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -193,9 +197,11 @@ func (h *DatadogServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validation.All(validateLogFormat(), validateLoggingJSONFormat()),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
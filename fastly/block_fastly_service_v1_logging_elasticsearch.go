@@ -88,6 +88,9 @@ func (h *ElasticSearchServiceAttributeHandler) Process(d *schema.ResourceData, l
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -165,7 +168,7 @@ func (h *ElasticSearchServiceAttributeHandler) Read(d *schema.ResourceData, s *g
 	ell := flattenElasticsearch(elasticsearchList)
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
-		log.Printf("[WARN] Error setting Elasticsearch logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Elasticsearch logging endpoints for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -231,7 +234,8 @@ func (h *ElasticSearchServiceAttributeHandler) Register(s *schema.Resource) erro
 			Description: "A secure certificate to authenticate the server with. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_cert": {
@@ -240,7 +244,8 @@ func (h *ElasticSearchServiceAttributeHandler) Register(s *schema.Resource) erro
 			Description: "The client certificate used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_key": {
@@ -249,7 +254,8 @@ func (h *ElasticSearchServiceAttributeHandler) Register(s *schema.Resource) erro
 			Description: "The client private key used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMPrivateKey(),
 		},
 
 		"tls_hostname": {
@@ -261,10 +267,12 @@ func (h *ElasticSearchServiceAttributeHandler) Register(s *schema.Resource) erro
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
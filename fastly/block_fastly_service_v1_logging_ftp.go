@@ -103,6 +103,9 @@ func (h *FTPServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -180,7 +183,7 @@ func (h *FTPServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 	ell := flattenFTP(ftpList)
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
-		log.Printf("[WARN] Error setting FTP logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting FTP logging endpoints for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -267,9 +270,11 @@ func (h *FTPServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
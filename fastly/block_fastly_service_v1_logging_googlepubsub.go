@@ -60,9 +60,11 @@ func (h *GooglePubSubServiceAttributeHandler) Register(s *schema.Resource) error
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -161,6 +163,9 @@ func (h *GooglePubSubServiceAttributeHandler) Process(d *schema.ResourceData, la
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -217,7 +222,7 @@ func (h *GooglePubSubServiceAttributeHandler) Read(d *schema.ResourceData, s *go
 	googlepubsubLogList := flattenGooglePubSub(googlepubsubList)
 
 	if err := d.Set(h.GetKey(), googlepubsubLogList); err != nil {
-		log.Printf("[WARN] Error setting Google Cloud Pub/Sublogging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Google Cloud Pub/Sublogging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -88,6 +88,9 @@ func (h *HerokuServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -138,7 +141,7 @@ func (h *HerokuServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly
 	ell := flattenHeroku(herokuList)
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
-		log.Printf("[WARN] Error setting Heroku logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Heroku logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -245,9 +248,11 @@ func (h *HerokuServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache-style string or VCL variables to use for log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache-style string or VCL variables to use for log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -6,6 +6,7 @@ import (
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 type HoneycombServiceAttributeHandler struct {
@@ -88,6 +89,9 @@ func (h *HoneycombServiceAttributeHandler) Process(d *schema.ResourceData, lates
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -138,7 +142,7 @@ func (h *HoneycombServiceAttributeHandler) Read(d *schema.ResourceData, s *gofas
 	ell := flattenHoneycomb(honeycombList)
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
-		log.Printf("[WARN] Error setting Honeycomb logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Honeycomb logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -245,9 +249,11 @@ func (h *HoneycombServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting. Your log must produce valid JSON that Honeycomb can ingest.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting. Your log must produce valid JSON that Honeycomb can ingest.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validation.All(validateLogFormat(), validateLoggingJSONFormat()),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -37,22 +37,25 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 		},
 
 		"brokers": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "A comma-separated list of IP addresses or hostnames of Kafka brokers",
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "A comma-separated list of IP addresses or hostnames of Kafka brokers",
+			ValidateFunc: validateKafkaBrokers(),
 		},
 
 		// Optional
 		"compression_codec": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The codec used for compression of your logs. One of: `gzip`, `snappy`, `lz4`",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The codec used for compression of your logs. One of: `gzip`, `snappy`, `lz4`",
+			ValidateFunc: validateKafkaCompressionCodec(),
 		},
 
 		"required_acks": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Description: "The Number of acknowledgements a leader must receive before a write is considered successful. One of: `1` (default) One server needs to respond. `0` No servers need to respond. `-1`	Wait for all in-sync replicas to respond",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The Number of acknowledgements a leader must receive before a write is considered successful. One of: `1` (default) One server needs to respond. `0` No servers need to respond. `-1`	Wait for all in-sync replicas to respond",
+			ValidateFunc: validateKafkaRequiredACKs(),
 		},
 
 		"use_tls": {
@@ -68,7 +71,8 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "A secure certificate to authenticate the server with. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_cert": {
@@ -77,7 +81,8 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "The client certificate used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMCertificates(),
 		},
 
 		"tls_client_key": {
@@ -86,7 +91,8 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "The client private key used to make authenticated requests. Must be in PEM format",
 			Sensitive:   true,
 			// Related issue for weird behavior - https://github.com/hashicorp/terraform-plugin-sdk/issues/160
-			StateFunc: trimSpaceStateFunc,
+			StateFunc:    trimSpaceStateFunc,
+			ValidateFunc: validatePEMPrivateKey(),
 		},
 
 		"tls_hostname": {
@@ -124,14 +130,17 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: "SASL Pass",
+			Sensitive:   true,
 		},
 	}
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -245,6 +254,9 @@ func (h *KafkaServiceAttributeHandler) Process(d *schema.ResourceData, latestVer
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -331,7 +343,7 @@ func (h *KafkaServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.
 	kafkaLogList := flattenKafka(kafkaList)
 
 	if err := d.Set(h.GetKey(), kafkaLogList); err != nil {
-		log.Printf("[WARN] Error setting Kafka logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Kafka logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -88,6 +88,9 @@ func (h *NewRelicServiceAttributeHandler) Process(d *schema.ResourceData, latest
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -135,7 +138,7 @@ func (h *NewRelicServiceAttributeHandler) Read(d *schema.ResourceData, s *gofast
 	dll := flattenNewRelic(newrelicList)
 
 	if err := d.Set(h.GetKey(), dll); err != nil {
-		log.Printf("[WARN] Error setting New Relic logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting New Relic logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -232,9 +235,11 @@ func (h *NewRelicServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting. Your log must produce valid JSON that New Relic Logs can ingest.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting. Your log must produce valid JSON that New Relic Logs can ingest.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -103,6 +103,9 @@ func (h *OpenstackServiceAttributeHandler) Process(d *schema.ResourceData, lates
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -180,7 +183,7 @@ func (h *OpenstackServiceAttributeHandler) Read(d *schema.ResourceData, s *gofas
 	ell := flattenOpenstack(openstackList)
 
 	if err := d.Set(h.GetKey(), ell); err != nil {
-		log.Printf("[WARN] Error setting OpenStack logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting OpenStack logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -359,9 +362,11 @@ func (h *OpenstackServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -48,9 +48,11 @@ func (h *ScalyrServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Apache style log formatting.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Description:      "Apache style log formatting.",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -149,6 +151,9 @@ func (h *ScalyrServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -199,7 +204,7 @@ func (h *ScalyrServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly
 	scalyrLogList := flattenScalyr(scalyrList)
 
 	if err := d.Set(h.GetKey(), scalyrLogList); err != nil {
-		log.Printf("[WARN] Error setting Scalyr logging endpoints for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Scalyr logging endpoints for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -24,10 +24,9 @@ func NewServicePackage(sa ServiceMetadata) ServiceAttributeDefinition {
 func (h *PackageServiceAttributeHandler) Register(s *schema.Resource) error {
 	s.Schema[h.GetKey()] = &schema.Schema{
 		Type:        schema.TypeList,
-		Required:    true,
-		Description: "The `package` block supports uploading or modifying Wasm packages for use in a Fastly Compute@Edge service. See Fastly's documentation on [Compute@Edge](https://developer.fastly.com/learning/compute/)",
+		Optional:    true,
+		Description: "The `package` block supports uploading or modifying Wasm packages for use in a Fastly Compute@Edge service. See Fastly's documentation on [Compute@Edge](https://developer.fastly.com/learning/compute/). May be omitted to bootstrap the service before the first package exists, in which case `activate` must be set to `false` until one is deployed",
 		MaxItems:    1,
-		MinItems:    1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"filename": {
@@ -42,6 +41,35 @@ func (h *PackageServiceAttributeHandler) Register(s *schema.Resource) error {
 					Computed:    true,
 					Description: `Used to trigger updates. Must be set to a SHA512 hash of the package file specified with the filename. The usual way to set this is filesha512("package.tar.gz") (Terraform 0.11.12 and later) or filesha512(file("package.tar.gz")) (Terraform 0.11.11 and earlier), where "package.tar.gz" is the local filename of the Wasm deployment package`,
 				},
+				// Metadata the API extracted from the uploaded package's
+				// manifest, exposed so automation and audits can verify what
+				// is actually deployed.
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the deployed package, from its manifest",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The description of the deployed package, from its manifest",
+				},
+				"authors": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The authors of the deployed package, from its manifest",
+				},
+				"language": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The language/toolchain the deployed package was built with, e.g. `rust`",
+				},
+				"size": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The size of the deployed package in bytes",
+				},
 			},
 		},
 	}
@@ -69,6 +97,12 @@ func (h *PackageServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 }
 
 func (h *PackageServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.ServiceDetail, conn *gofastly.Client) error {
+	// A bootstrapped service has no package block and nothing to refresh;
+	// packages deployed out of band (e.g. by CI) are left alone.
+	if _, ok := d.GetOk(h.GetKey()); !ok {
+		return nil
+	}
+
 	log.Printf("[DEBUG] Refreshing package for (%s)", d.Id())
 	Package, err := conn.GetPackage(&gofastly.GetPackageInput{
 		ServiceID:      d.Id(),
@@ -76,13 +110,17 @@ func (h *PackageServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastl
 	})
 
 	if err != nil {
+		if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.IsNotFound() {
+			log.Printf("[WARN] No package uploaded for (%s), version (%v)", d.Id(), s.ActiveVersion.Number)
+			return d.Set(h.GetKey(), []map[string]interface{}{})
+		}
 		return fmt.Errorf("[ERR] Error looking up Package for (%s), version (%v): %v", d.Id(), s.ActiveVersion.Number, err)
 	}
 
 	filename := d.Get("package.0.filename").(string)
 	wp := flattenPackage(Package, filename)
 	if err := d.Set(h.GetKey(), wp); err != nil {
-		log.Printf("[WARN] Error setting Package for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Package for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -95,9 +133,20 @@ func updatePackage(conn *gofastly.Client, i *gofastly.UpdatePackageInput) error
 
 func flattenPackage(Package *gofastly.Package, filename string) []map[string]interface{} {
 	var pa []map[string]interface{}
+
+	authors := make([]interface{}, 0, len(Package.Metadata.Authors))
+	for _, a := range Package.Metadata.Authors {
+		authors = append(authors, a)
+	}
+
 	p := map[string]interface{}{
 		"source_code_hash": Package.Metadata.HashSum,
 		"filename":         filename,
+		"name":             Package.Metadata.Name,
+		"description":      Package.Metadata.Description,
+		"authors":          authors,
+		"language":         Package.Metadata.Language,
+		"size":             int(Package.Metadata.Size),
 	}
 
 	// Convert Package to a map for saving to state.
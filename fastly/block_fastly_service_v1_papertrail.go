@@ -107,6 +107,9 @@ func (h *PaperTrailServiceAttributeHandler) Process(d *schema.ResourceData, late
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -156,7 +159,7 @@ func (h *PaperTrailServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 	pl := flattenPapertrails(papertrailList)
 
 	if err := d.Set(h.GetKey(), pl); err != nil {
-		log.Printf("[WARN] Error setting Papertrail for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Papertrail for (%s): %s", d.Id(), err)
 	}
 
 	return nil
@@ -184,10 +187,12 @@ func (h *PaperTrailServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t %r %>s",
-			Description: "A Fastly [log format string](https://docs.fastly.com/en/guides/custom-log-formats)",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t %r %>s",
+			Description:      "A Fastly [log format string](https://docs.fastly.com/en/guides/custom-log-formats)",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
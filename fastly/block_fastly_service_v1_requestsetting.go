@@ -100,6 +100,9 @@ func (h *RequestSettingServiceAttributeHandler) Process(d *schema.ResourceData,
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		if v, ok := modified["force_miss"]; ok {
 			opts.ForceMiss = gofastly.CBool(v.(bool))
@@ -175,7 +178,7 @@ func (h *RequestSettingServiceAttributeHandler) Read(d *schema.ResourceData, s *
 	rl := flattenRequestSettings(rsList)
 
 	if err := d.Set(h.GetKey(), rl); err != nil {
-		log.Printf("[WARN] Error setting Request Settings for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Request Settings for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
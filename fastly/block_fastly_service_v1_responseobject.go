@@ -1,7 +1,10 @@
 package fastly
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"log"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
@@ -70,21 +73,25 @@ func (h *ResponseObjectServiceAttributeHandler) Process(d *schema.ResourceData,
 	for _, resource := range diffResult.Added {
 		resource := resource.(map[string]interface{})
 
+		content, err := responseObjectContent(resource)
+		if err != nil {
+			return err
+		}
+
 		opts := gofastly.CreateResponseObjectInput{
 			ServiceID:        d.Id(),
 			ServiceVersion:   latestVersion,
 			Name:             resource["name"].(string),
 			Status:           uint(resource["status"].(int)),
 			Response:         resource["response"].(string),
-			Content:          resource["content"].(string),
+			Content:          content,
 			ContentType:      resource["content_type"].(string),
 			RequestCondition: resource["request_condition"].(string),
 			CacheCondition:   resource["cache_condition"].(string),
 		}
 
 		log.Printf("[DEBUG] Create Response Object Opts: %#v", opts)
-		_, err := conn.CreateResponseObject(&opts)
-		if err != nil {
+		if _, err := conn.CreateResponseObject(&opts); err != nil {
 			return err
 		}
 	}
@@ -105,6 +112,9 @@ func (h *ResponseObjectServiceAttributeHandler) Process(d *schema.ResourceData,
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -117,8 +127,15 @@ func (h *ResponseObjectServiceAttributeHandler) Process(d *schema.ResourceData,
 		if v, ok := modified["response"]; ok {
 			opts.Response = gofastly.String(v.(string))
 		}
-		if v, ok := modified["content"]; ok {
-			opts.Content = gofastly.String(v.(string))
+		_, contentChanged := modified["content"]
+		_, fileChanged := modified["content_file"]
+		_, hashChanged := modified["content_sha256"]
+		if contentChanged || fileChanged || hashChanged {
+			content, err := responseObjectContent(resource)
+			if err != nil {
+				return err
+			}
+			opts.Content = gofastly.String(content)
 		}
 		if v, ok := modified["content_type"]; ok {
 			opts.ContentType = gofastly.String(v.(string))
@@ -153,8 +170,31 @@ func (h *ResponseObjectServiceAttributeHandler) Read(d *schema.ResourceData, s *
 
 	rol := flattenResponseObjects(responseObjectList)
 
+	// For blocks sourced from content_file, state tracks the file path and a
+	// hash of the delivered content rather than the body itself, so refresh
+	// keeps mirroring what the user wrote.
+	if v, ok := d.GetOk(h.GetKey()); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			m, ok := raw.(map[string]interface{})
+			if !ok || m["content_file"].(string) == "" {
+				continue
+			}
+			for _, nro := range rol {
+				if nro["name"] != m["name"] {
+					continue
+				}
+				nro["content_file"] = m["content_file"]
+				if content, ok := nro["content"].(string); ok {
+					sum := sha256.Sum256([]byte(content))
+					nro["content_sha256"] = hex.EncodeToString(sum[:])
+					delete(nro, "content")
+				}
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), rol); err != nil {
-		log.Printf("[WARN] Error setting Response Object for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Response Object for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -188,13 +228,27 @@ func (h *ResponseObjectServiceAttributeHandler) Register(s *schema.Resource) err
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "The content to deliver for the response object",
+					Description: "The content to deliver for the response object. Conflicts with `content_file`",
 				},
-				"content_type": {
+				"content_file": {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Default:     "",
-					Description: "The MIME type of the content",
+					Description: "The path to a local file whose contents to deliver for the response object, for bodies too large to inline comfortably (e.g. maintenance pages). Conflicts with `content`. Set `content_sha256` alongside it to trigger updates when the file changes",
+				},
+				// sha256 hash of the delivered content
+				"content_sha256": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: `Used to trigger updates when content_file changes. Must be set to a SHA256 hash of the file specified with content_file; the usual way is filesha256("error.html")`,
+				},
+				"content_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  "The MIME type of the content",
+					ValidateFunc: validateContentType(),
 				},
 				"request_condition": {
 					Type:        schema.TypeString,
@@ -214,6 +268,19 @@ func (h *ResponseObjectServiceAttributeHandler) Register(s *schema.Resource) err
 	return nil
 }
 
+// responseObjectContent resolves the body to deliver for a response object,
+// reading it from `content_file` when that is set instead of `content`.
+func responseObjectContent(resource map[string]interface{}) (string, error) {
+	if path, ok := resource["content_file"].(string); ok && path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("response object %q: error reading content_file: %s", resource["name"], err)
+		}
+		return string(b), nil
+	}
+	return resource["content"].(string), nil
+}
+
 func flattenResponseObjects(responseObjectList []*gofastly.ResponseObject) []map[string]interface{} {
 	var rol []map[string]interface{}
 	for _, ro := range responseObjectList {
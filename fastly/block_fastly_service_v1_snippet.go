@@ -114,6 +114,9 @@ func (h *SnippetServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -157,6 +160,10 @@ func (h *SnippetServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 			}
 		}
 
+		if normalize, _ := resource["normalize_content"].(bool); normalize {
+			opts.Content = normalizeSnippetContent(opts.Content)
+		}
+
 		log.Printf("[DEBUG] Update VCL Snippet Opts: %#v", opts)
 		_, err := conn.UpdateSnippet(&opts)
 		if err != nil {
@@ -179,8 +186,29 @@ func (h *SnippetServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastl
 
 	vsl := flattenSnippets(snippetList)
 
+	// Match up normalize_content from state, which the API knows nothing
+	// about, and keep the configured form of normalized content so a CRLF or
+	// trailing-newline difference does not show as drift.
+	stateSnippets := d.Get(h.GetKey()).(*schema.Set).List()
+	for _, snippet := range vsl {
+		for _, ss := range stateSnippets {
+			stateSnippet := ss.(map[string]interface{})
+			if snippet["name"] != stateSnippet["name"] {
+				continue
+			}
+			if normalize, _ := stateSnippet["normalize_content"].(bool); normalize {
+				snippet["normalize_content"] = true
+				stateContent, _ := stateSnippet["content"].(string)
+				if apiContent, _ := snippet["content"].(string); normalizeSnippetContent(stateContent) == apiContent {
+					snippet["content"] = stateContent
+				}
+			}
+			break
+		}
+	}
+
 	if err := d.Set(h.GetKey(), vsl); err != nil {
-		log.Printf("[WARN] Error setting VCL Snippets for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting VCL Snippets for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -213,6 +241,12 @@ func (h *SnippetServiceAttributeHandler) Register(s *schema.Resource) error {
 					Default:     100,
 					Description: "Priority determines the ordering for multiple snippets. Lower numbers execute first. Defaults to `100`",
 				},
+				"normalize_content": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Normalize `content` before uploading: CRLF line endings become LF and trailing newlines are reduced to one, so editor and platform differences do not show as drift. Default `false`",
+				},
 			},
 		},
 	}
@@ -221,9 +255,13 @@ func (h *SnippetServiceAttributeHandler) Register(s *schema.Resource) error {
 
 func buildSnippet(snippetMap interface{}) (*gofastly.CreateSnippetInput, error) {
 	df := snippetMap.(map[string]interface{})
+	content := df["content"].(string)
+	if normalize, _ := df["normalize_content"].(bool); normalize {
+		content = normalizeSnippetContent(content)
+	}
 	opts := gofastly.CreateSnippetInput{
 		Name:     df["name"].(string),
-		Content:  df["content"].(string),
+		Content:  content,
 		Priority: df["priority"].(int),
 	}
 
@@ -106,6 +106,9 @@ func (h *SumologicServiceAttributeHandler) Process(d *schema.ResourceData, lates
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -157,7 +160,7 @@ func (h *SumologicServiceAttributeHandler) Read(d *schema.ResourceData, s *gofas
 
 	sul := flattenSumologics(sumologicList)
 	if err := d.Set(h.GetKey(), sul); err != nil {
-		log.Printf("[WARN] Error setting Sumologic for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Sumologic for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -187,10 +190,12 @@ func (h *SumologicServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t %r %>s",
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t %r %>s",
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
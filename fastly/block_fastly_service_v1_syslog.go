@@ -113,6 +113,9 @@ func (h *SyslogServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		// NOTE: where we transition between interface{} we lose the ability to
 		// infer the underlying type being either a uint vs an int. This
@@ -189,7 +192,7 @@ func (h *SyslogServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly
 	sll := flattenSyslogs(syslogList)
 
 	if err := d.Set(h.GetKey(), sll); err != nil {
-		log.Printf("[WARN] Error setting Syslog for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting Syslog for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
@@ -219,6 +222,7 @@ func (h *SyslogServiceAttributeHandler) Register(s *schema.Resource) error {
 			Optional:    true,
 			Default:     "",
 			Description: "Whether to prepend each message with a specific token",
+			Sensitive:   true,
 		},
 		"use_tls": {
 			Type:        schema.TypeBool,
@@ -233,23 +237,26 @@ func (h *SyslogServiceAttributeHandler) Register(s *schema.Resource) error {
 			Description: "Used during the TLS handshake to validate the certificate",
 		},
 		"tls_ca_cert": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("FASTLY_SYSLOG_CA_CERT", ""),
-			Description: "A secure certificate to authenticate the server with. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CA_CERT`",
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CA_CERT", ""),
+			Description:  "A secure certificate to authenticate the server with. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CA_CERT`",
+			ValidateFunc: validatePEMCertificates(),
 		},
 		"tls_client_cert": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_CERT", ""),
-			Description: "The client certificate used to make authenticated requests. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CLIENT_CERT`",
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_CERT", ""),
+			Description:  "The client certificate used to make authenticated requests. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CLIENT_CERT`",
+			ValidateFunc: validatePEMCertificates(),
 		},
 		"tls_client_key": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			DefaultFunc: schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_KEY", ""),
-			Description: "The client private key used to make authenticated requests. Must be in PEM format. You can provide this key via an environment variable, `FASTLY_SYSLOG_CLIENT_KEY`",
-			Sensitive:   true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_KEY", ""),
+			Description:  "The client private key used to make authenticated requests. Must be in PEM format. You can provide this key via an environment variable, `FASTLY_SYSLOG_CLIENT_KEY`",
+			Sensitive:    true,
+			ValidateFunc: validatePEMPrivateKey(),
 		},
 		"message_type": {
 			Type:         schema.TypeString,
@@ -262,10 +269,12 @@ func (h *SyslogServiceAttributeHandler) Register(s *schema.Resource) error {
 
 	if h.GetServiceMetadata().serviceType == ServiceTypeVCL {
 		blockAttributes["format"] = &schema.Schema{
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "%h %l %u %t \"%r\" %>s %b",
-			Description: "Apache-style string or VCL variables to use for log formatting",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "%h %l %u %t \"%r\" %>s %b",
+			Description:      "Apache-style string or VCL variables to use for log formatting",
+			DiffSuppressFunc: diffSuppressTrailingWhitespace,
+			ValidateFunc:     validateLogFormat(),
 		}
 		blockAttributes["format_version"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -117,6 +117,9 @@ func (h *VCLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 
 		// only attempt to update attributes that have changed
 		modified := setDiff.Filter(resource, oldSet)
+		if len(modified) == 0 {
+			continue
+		}
 
 		if v, ok := modified["content"]; ok {
 			opts.Content = gofastly.String(v.(string))
@@ -145,7 +148,7 @@ func (h *VCLServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 	vl := flattenVCLs(vclList)
 
 	if err := d.Set(h.GetKey(), vl); err != nil {
-		log.Printf("[WARN] Error setting VCLs for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting VCLs for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
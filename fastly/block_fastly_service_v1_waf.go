@@ -109,7 +109,7 @@ func (h *WAFServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 	waf := flattenWAFs(wafList.Items)
 
 	if err := d.Set("waf", waf); err != nil {
-		log.Printf("[WARN] Error setting waf for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting waf for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
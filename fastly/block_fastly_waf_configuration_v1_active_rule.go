@@ -1,6 +1,7 @@
 package fastly
 
 import (
+	"fmt"
 	"log"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
@@ -90,7 +91,7 @@ func readWAFRules(meta interface{}, d *schema.ResourceData, v int) error {
 	rules := flattenWAFActiveRules(resp.Items)
 
 	if err := d.Set("rule", rules); err != nil {
-		log.Printf("[WARN] Error setting WAF rules for (%s): %s", d.Id(), err)
+		return fmt.Errorf("[ERR] Error setting WAF rules for (%s): %s", d.Id(), err)
 	}
 	return nil
 }
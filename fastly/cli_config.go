@@ -0,0 +1,70 @@
+package fastly
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fastlyCLIConfigFile returns the location of the Fastly CLI configuration
+// file, honouring the FASTLY_CLI_CONFIG environment variable.
+func fastlyCLIConfigFile() string {
+	if path := os.Getenv("FASTLY_CLI_CONFIG"); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".fastly", "config.toml")
+}
+
+// readFastlyCLIToken reads an API token from the Fastly CLI configuration
+// file, so users already authenticated with `fastly configure` do not have to
+// repeat their token in the provider block or environment. The file is a
+// simple TOML document; rather than pulling in a TOML dependency for a single
+// key, this scans for the first `token = "..."` assignment in the `[user]`
+// section or in any `[profile...]` section. Returns an empty string when no
+// token can be found.
+func readFastlyCLIToken() string {
+	path := fastlyCLIConfigFile()
+	if path == "" {
+		return ""
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	var section string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.Trim(line, "[]")
+			continue
+		}
+
+		if section != "user" && !strings.HasPrefix(section, "profile") {
+			continue
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) != "token" {
+			continue
+		}
+
+		token := strings.TrimSpace(parts[1])
+		token = strings.Trim(token, `"'`)
+		if token != "" {
+			return token
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,68 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadFastlyCLIToken(t *testing.T) {
+	cases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name: "user section",
+			content: `config_version = 1
+
+[user]
+email = "user@example.com"
+token = "abc123"
+`,
+			expected: "abc123",
+		},
+		{
+			name: "profile section",
+			content: `[profile.default]
+default = true
+email = "user@example.com"
+token = "def456"
+`,
+			expected: "def456",
+		},
+		{
+			name: "token outside known sections is ignored",
+			content: `token = "nope"
+
+[fastly]
+api_endpoint = "https://api.fastly.com"
+`,
+			expected: "",
+		},
+		{
+			name:     "empty file",
+			content:  "",
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "fastly-cli-config")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "config.toml")
+			assert.NoError(t, ioutil.WriteFile(path, []byte(c.content), 0600))
+
+			os.Setenv("FASTLY_CLI_CONFIG", path)
+			defer os.Unsetenv("FASTLY_CLI_CONFIG")
+
+			assert.Equal(t, c.expected, readFastlyCLIToken())
+		})
+	}
+}
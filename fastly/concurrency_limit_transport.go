@@ -0,0 +1,26 @@
+package fastly
+
+import "net/http"
+
+// concurrencyLimitTransport bounds the number of in-flight requests to the
+// Fastly API. Terraform can issue many API calls in parallel (multiple
+// resources, parallelized reads) and some accounts have strict rate limits,
+// so the provider allows capping concurrency via `max_api_concurrency`.
+type concurrencyLimitTransport struct {
+	transport http.RoundTripper
+	semaphore chan struct{}
+}
+
+func newConcurrencyLimitTransport(transport http.RoundTripper, limit int) *concurrencyLimitTransport {
+	return &concurrencyLimitTransport{
+		transport: transport,
+		semaphore: make(chan struct{}, limit),
+	}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.semaphore <- struct{}{}
+	defer func() { <-t.semaphore }()
+
+	return t.transport.RoundTrip(req)
+}
@@ -1,11 +1,17 @@
 package fastly
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/fastly/terraform-provider-fastly/version"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/httpclient"
 )
 
@@ -15,23 +21,89 @@ type Config struct {
 	ApiKey  string
 	BaseURL string
 
+	// MaxAPIConcurrency caps the number of in-flight requests to the Fastly
+	// API. Zero means no limit.
+	MaxAPIConcurrency int
+
+	// HTTPProxy is the URL of a proxy to route API requests through. When
+	// empty, the standard proxy environment variables apply.
+	HTTPProxy string
+
+	// CACertFile is the path to a PEM bundle of CA certificates appended to
+	// the system roots when verifying the API endpoint, for environments that
+	// intercept TLS.
+	CACertFile string
+
+	// UserAgentSuffix is appended to the User-Agent header on API requests so
+	// orchestration tooling wrapping Terraform can be identified in logs.
+	UserAgentSuffix string
+
+	// ValidateToken verifies the API token and its scope at configure time,
+	// failing fast instead of part-way through an apply.
+	ValidateToken bool
+
+	// APITelemetryFile is the path of a JSON file to which a per-run summary
+	// of API calls, grouped by endpoint and status, is written. Empty disables
+	// telemetry.
+	APITelemetryFile string
+
+	// Offline disables all network access to the Fastly API: reads are served
+	// from OfflineFixturesDir and writes are refused, so a plan can run in CI
+	// without a real token.
+	Offline bool
+
+	// OfflineFixturesDir is the directory of canned JSON responses consulted
+	// for reads in offline mode.
+	OfflineFixturesDir string
+
 	terraformVersion string
 }
 
 type FastlyClient struct {
 	conn *gofastly.Client
+
+	// serviceLocks serializes operations from different resources that target
+	// the same service within a single Terraform run.
+	serviceLocks *serviceMutex
+
+	// serviceDetails memoizes service detail lookups, invalidated on writes.
+	serviceDetails *serviceDetailsCache
 }
 
 func (c *Config) Client() (*FastlyClient, error) {
 	var client FastlyClient
 
+	// Fall back to the token stored by the Fastly CLI when no API key has been
+	// supplied via the provider block or environment.
+	if c.ApiKey == "" {
+		c.ApiKey = readFastlyCLIToken()
+	}
+
+	// The whole point of offline mode is to run without a token; use a
+	// placeholder so the client can be constructed.
+	if c.ApiKey == "" && c.Offline {
+		c.ApiKey = "offline"
+	}
+
 	if c.ApiKey == "" {
 		return nil, fmt.Errorf("[Err] No API key for Fastly")
 	}
 
+	// The base URL is already configurable via the `base_url` provider
+	// attribute and the FASTLY_API_URL environment variable. Normalize it here
+	// so values with a trailing slash produce the same request paths as the
+	// default endpoint.
+	if c.BaseURL == "" {
+		c.BaseURL = gofastly.DefaultEndpoint
+	}
+	c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
+
 	tfUserAgent := httpclient.TerraformUserAgent(c.terraformVersion)
 	providerUserAgent := fmt.Sprintf("%s/%s", TerraformProviderProductUserAgent, version.ProviderVersion)
 	ua := fmt.Sprintf("%s %s", tfUserAgent, providerUserAgent)
+	if c.UserAgentSuffix != "" {
+		ua = fmt.Sprintf("%s %s", ua, c.UserAgentSuffix)
+	}
 	gofastly.UserAgent = ua
 
 	fastlyClient, err := gofastly.NewClientForEndpoint(c.ApiKey, c.BaseURL)
@@ -39,8 +111,111 @@ func (c *Config) Client() (*FastlyClient, error) {
 		return nil, err
 	}
 
-	fastlyClient.HTTPClient.Transport = logging.NewTransport("Fastly", fastlyClient.HTTPClient.Transport)
+	// Offline mode replaces the real transport outright: no proxy, retry or
+	// telemetry layers apply, as nothing reaches the network.
+	if c.Offline {
+		fastlyClient.HTTPClient.Transport = &offlineTransport{fixturesDir: c.OfflineFixturesDir}
+		fastlyClient.HTTPClient.Transport = newDebugLoggingTransport("Fastly", fastlyClient.HTTPClient.Transport)
+
+		if c.ValidateToken {
+			return nil, fmt.Errorf("[Err] validate_token cannot be used in offline mode")
+		}
+
+		client.conn = fastlyClient
+		client.serviceLocks = newServiceMutex()
+		client.serviceDetails = newServiceDetailsCache()
+		return &client, nil
+	}
+
+	if err := c.configureTransport(fastlyClient); err != nil {
+		return nil, err
+	}
+
+	// Telemetry wraps the innermost transport so retried requests are each
+	// counted as the API sees them.
+	if c.APITelemetryFile != "" {
+		fastlyClient.HTTPClient.Transport = newAPITelemetryTransport(fastlyClient.HTTPClient.Transport, c.APITelemetryFile)
+	}
+	fastlyClient.HTTPClient.Transport = newDebugLoggingTransport("Fastly", fastlyClient.HTTPClient.Transport)
+	fastlyClient.HTTPClient.Transport = &serverErrorRetryTransport{transport: fastlyClient.HTTPClient.Transport}
+	fastlyClient.HTTPClient.Transport = &rateLimitRetryTransport{transport: fastlyClient.HTTPClient.Transport}
+	fastlyClient.HTTPClient.Transport = &forbiddenGuidanceTransport{transport: fastlyClient.HTTPClient.Transport}
+	fastlyClient.HTTPClient.Transport = &apiErrorContextTransport{transport: fastlyClient.HTTPClient.Transport}
+	if c.MaxAPIConcurrency > 0 {
+		fastlyClient.HTTPClient.Transport = newConcurrencyLimitTransport(fastlyClient.HTTPClient.Transport, c.MaxAPIConcurrency)
+	}
+
+	if c.ValidateToken {
+		if err := validateToken(fastlyClient); err != nil {
+			return nil, err
+		}
+	}
 
 	client.conn = fastlyClient
+	client.serviceLocks = newServiceMutex()
+	client.serviceDetails = newServiceDetailsCache()
 	return &client, nil
 }
+
+// validateToken checks that the configured API token is valid and has a scope
+// capable of managing service configuration.
+func validateToken(fastlyClient *gofastly.Client) error {
+	t, err := fastlyClient.GetTokenSelf()
+	if err != nil {
+		if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.StatusCode == 401 {
+			return fmt.Errorf("[Err] The Fastly API token is invalid or has expired")
+		}
+		return fmt.Errorf("[Err] Error validating the Fastly API token: %s", err)
+	}
+
+	switch t.Scope {
+	case gofastly.GlobalReadScope, gofastly.PurgeSelectScope, gofastly.PurgeAllScope:
+		return fmt.Errorf("[Err] The Fastly API token has scope %q, which cannot manage service configuration; a token with scope %q is required", t.Scope, gofastly.GlobalScope)
+	}
+
+	log.Printf("[DEBUG] Fastly API token validated, scope: %s", t.Scope)
+	return nil
+}
+
+// configureTransport applies the optional proxy and custom CA settings to the
+// underlying HTTP transport of the Fastly API client.
+func (c *Config) configureTransport(fastlyClient *gofastly.Client) error {
+	if c.HTTPProxy == "" && c.CACertFile == "" {
+		return nil
+	}
+
+	transport, ok := fastlyClient.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("[Err] Cannot configure proxy or CA certificates on transport of type %T", fastlyClient.HTTPClient.Transport)
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return fmt.Errorf("[Err] Invalid http_proxy URL %q: %s", c.HTTPProxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if c.CACertFile != "" {
+		pem, err := ioutil.ReadFile(c.CACertFile)
+		if err != nil {
+			return fmt.Errorf("[Err] Error reading ca_cert_file %q: %s", c.CACertFile, err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("[Err] No CA certificates found in ca_cert_file %q", c.CACertFile)
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	return nil
+}
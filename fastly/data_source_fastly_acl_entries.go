@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFastlyACLEntries() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyACLEntriesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Service that the ACL belongs to.",
+			},
+			"acl_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the ACL to read entries from.",
+			},
+			"entries": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The current entries of the ACL, for consumption by systems that reference but do not manage them.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the ACL entry.",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An IP address that is the focus for the ACL.",
+						},
+						"subnet": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An optional subnet mask applied to the IP address.",
+						},
+						"negated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "A boolean that will negate the match if true.",
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A personal freeform descriptive note.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFastlyACLEntriesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	aclID := d.Get("acl_id").(string)
+
+	log.Printf("[INFO] Reading ACL entries for service %s, ACL %s", serviceID, aclID)
+	aclEntries, err := listACLEntriesPaginated(conn, serviceID, aclID)
+	if err != nil {
+		return fmt.Errorf("error listing ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, aclID))
+	if err := d.Set("entries", flattenAclEntries(aclEntries)); err != nil {
+		return fmt.Errorf("error setting ACL entries: service %s, ACL %s, %s", serviceID, aclID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceFastlyACLEntries_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	aclName := fmt.Sprintf("tf_test_acl_%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFastlyACLEntriesConfig(serviceName, domainName, aclName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_acl_entries.entries", "entries.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFastlyACLEntriesConfig(serviceName, domainName, aclName string) string {
+	return fmt.Sprintf(`
+variable "myacl_name" {
+	type = string
+	default = "%s"
+}
+
+resource "fastly_service_v1" "foo" {
+	name = "%s"
+	domain {
+		name    = "%s"
+		comment = "tf-testing-domain"
+	}
+	backend {
+		address = "aws.amazon.com"
+		name    = "tf-testing-backend"
+	}
+	acl {
+		name = var.myacl_name
+	}
+	force_destroy = true
+}
+
+resource "fastly_service_acl_entries_v1" "entries" {
+	service_id = fastly_service_v1.foo.id
+	acl_id = {for s in fastly_service_v1.foo.acl : s.name => s.acl_id}[var.myacl_name]
+	entry {
+		ip      = "127.0.0.1"
+		comment = "tf-testing-entry-1"
+	}
+	entry {
+		ip      = "192.0.2.0"
+		subnet  = "24"
+		comment = "tf-testing-entry-2"
+	}
+}
+
+data "fastly_acl_entries" "entries" {
+	service_id = fastly_service_v1.foo.id
+	acl_id     = fastly_service_acl_entries_v1.entries.acl_id
+}`, aclName, serviceName, domainName)
+}
@@ -0,0 +1,58 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFastlyDictionaryItems() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyDictionaryItemsRead,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Service that the dictionary belongs to.",
+			},
+			"dictionary_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the dictionary to read items from.",
+			},
+			"items": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The current items of the dictionary, for referencing values maintained by an external system without managing them.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFastlyDictionaryItemsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	dictionaryID := d.Get("dictionary_id").(string)
+
+	log.Printf("[INFO] Reading dictionary items for service %s, dictionary %s", serviceID, dictionaryID)
+	dictionaryItems, err := listDictionaryItemsPaginated(conn, serviceID, dictionaryID)
+	if err != nil {
+		return fmt.Errorf("error listing dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
+	}
+
+	items := make(map[string]string, len(dictionaryItems))
+	for _, item := range dictionaryItems {
+		items[item.ItemKey] = item.ItemValue
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, dictionaryID))
+	if err := d.Set("items", items); err != nil {
+		return fmt.Errorf("error setting dictionary items: service %s, dictionary %s, %s", serviceID, dictionaryID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceFastlyDictionaryItems_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	dictName := fmt.Sprintf("tf_test_dict_%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFastlyDictionaryItemsConfig(serviceName, domainName, dictName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_dictionary_items.items", "items.%", "2"),
+					resource.TestCheckResourceAttr("data.fastly_dictionary_items.items", "items.key1", "value1"),
+					resource.TestCheckResourceAttr("data.fastly_dictionary_items.items", "items.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFastlyDictionaryItemsConfig(serviceName, domainName, dictName string) string {
+	return fmt.Sprintf(`
+variable "mydict_name" {
+	type = string
+	default = "%s"
+}
+
+resource "fastly_service_v1" "foo" {
+	name = "%s"
+	domain {
+		name    = "%s"
+		comment = "tf-testing-domain"
+	}
+	backend {
+		address = "aws.amazon.com"
+		name    = "tf-testing-backend"
+	}
+	dictionary {
+		name = var.mydict_name
+	}
+	force_destroy = true
+}
+
+resource "fastly_service_dictionary_items_v1" "items" {
+	service_id = fastly_service_v1.foo.id
+	dictionary_id = {for d in fastly_service_v1.foo.dictionary : d.name => d.dictionary_id}[var.mydict_name]
+	items = {
+		key1 = "value1"
+		key2 = "value2"
+	}
+}
+
+data "fastly_dictionary_items" "items" {
+	service_id    = fastly_service_v1.foo.id
+	dictionary_id = fastly_service_dictionary_items_v1.items.dictionary_id
+}`, dictName, serviceName, domainName)
+}
@@ -0,0 +1,71 @@
+package fastly
+
+import (
+	"fmt"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFastlyGeneratedVCL() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyGeneratedVCLRead,
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service to fetch the generated VCL for.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the service to fetch the generated VCL for. Defaults to the active version.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The VCL generated by Fastly for this service version, including custom VCL and the config generated from the rest of the service settings.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyGeneratedVCLRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+	version := d.Get("version").(int)
+
+	if version == 0 {
+		s, err := client.serviceDetails.Get(conn, serviceID)
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up service (%s): %s", serviceID, err)
+		}
+
+		if s.ActiveVersion.Number == 0 {
+			return fmt.Errorf("[ERR] Service (%s) has no active version, set `version` explicitly to read a draft version", serviceID)
+		}
+		version = s.ActiveVersion.Number
+	}
+
+	vcl, err := conn.GetGeneratedVCL(&gofastly.GetGeneratedVCLInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up generated VCL for service (%s), version (%v): %s", serviceID, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", serviceID, version))
+
+	if err := d.Set("version", version); err != nil {
+		return err
+	}
+	if err := d.Set("content", vcl.Content); err != nil {
+		return err
+	}
+
+	return nil
+}
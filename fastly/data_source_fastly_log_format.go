@@ -0,0 +1,121 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// logFormatPresets maps a preset name to its format string per format_version.
+// These are maintained here so teams reference one curated definition instead
+// of hand-rolling subtly different format strings per service.
+var logFormatPresets = map[string]map[int]string{
+	// The classic Apache/NCSA style line, matching Fastly's default logging
+	// format. Identical in both format versions.
+	"apache_common": {
+		1: `%h %l %u %t "%r" %>s %b`,
+		2: `%h %l %u %t "%r" %>s %b`,
+	},
+	// Apache combined log format: common plus referer and user agent.
+	"apache_combined": {
+		1: `%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-Agent}i"`,
+		2: `%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-Agent}i"`,
+	},
+	// One JSON object per request covering the commonly needed fields.
+	// Requires format_version 2 for access to the req/resp VCL variables.
+	"json_standard": {
+		2: `{` +
+			`"timestamp":"%{strftime(\{"%Y-%m-%dT%H:%M:%S%z"\}, time.start)}V",` +
+			`"client_ip":"%{req.http.Fastly-Client-IP}V",` +
+			`"geo_country":"%{client.geo.country_name}V",` +
+			`"geo_datacenter":"%{server.datacenter}V",` +
+			`"host":"%{if(req.http.Fastly-Orig-Host, req.http.Fastly-Orig-Host, req.http.Host)}V",` +
+			`"url":"%{json.escape(req.url)}V",` +
+			`"request_method":"%{json.escape(req.method)}V",` +
+			`"protocol":"%{json.escape(req.proto)}V",` +
+			`"request_referer":"%{json.escape(req.http.Referer)}V",` +
+			`"request_user_agent":"%{json.escape(req.http.User-Agent)}V",` +
+			`"response_state":"%{json.escape(fastly_info.state)}V",` +
+			`"response_status":%{resp.status}V,` +
+			`"response_reason":%{if(resp.response, "%22"+json.escape(resp.response)+"%22", "null")}V,` +
+			`"response_body_size":%{resp.body_bytes_written}V,` +
+			`"fastly_server":"%{json.escape(server.identity)}V",` +
+			`"fastly_is_edge":%{if(fastly.ff.visits_this_service == 0, "true", "false")}V` +
+			`}`,
+	},
+	// Security-focused JSON object: connection, TLS and identity details
+	// useful for incident investigation. Requires format_version 2.
+	"json_security": {
+		2: `{` +
+			`"timestamp":"%{strftime(\{"%Y-%m-%dT%H:%M:%S%z"\}, time.start)}V",` +
+			`"client_ip":"%{req.http.Fastly-Client-IP}V",` +
+			`"geo_country_code":"%{client.geo.country_code}V",` +
+			`"request_id":"%{json.escape(req.http.X-Request-Id)}V",` +
+			`"host":"%{if(req.http.Fastly-Orig-Host, req.http.Fastly-Orig-Host, req.http.Host)}V",` +
+			`"url":"%{json.escape(req.url)}V",` +
+			`"request_method":"%{json.escape(req.method)}V",` +
+			`"request_user_agent":"%{json.escape(req.http.User-Agent)}V",` +
+			`"tls_protocol":"%{json.escape(tls.client.protocol)}V",` +
+			`"tls_cipher":"%{json.escape(tls.client.cipher)}V",` +
+			`"response_status":%{resp.status}V,` +
+			`"fastly_server":"%{json.escape(server.identity)}V",` +
+			`"fastly_is_edge":%{if(fastly.ff.visits_this_service == 0, "true", "false")}V` +
+			`}`,
+	},
+}
+
+func dataSourceFastlyLogFormat() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyLogFormatRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the log format preset. One of `apache_common`, `apache_combined`, `json_standard` or `json_security`.",
+				ValidateFunc: validation.StringInSlice(logFormatPresetNames(), false),
+			},
+			"format_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "The logging `format_version` the format targets. The JSON presets require version `2`. Default `2`.",
+				ValidateFunc: validation.IntBetween(1, 2),
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The format string, for use as the `format` of a logging endpoint.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyLogFormatRead(d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	formatVersion := d.Get("format_version").(int)
+
+	format, ok := logFormatPresets[name][formatVersion]
+	if !ok {
+		return fmt.Errorf("log format preset %q is not available for format_version %d", name, formatVersion)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(fmt.Sprintf("%s/%d", name, formatVersion))))
+	if err := d.Set("format", format); err != nil {
+		return fmt.Errorf("error setting format: %s", err)
+	}
+
+	return nil
+}
+
+func logFormatPresetNames() []string {
+	names := make([]string, 0, len(logFormatPresets))
+	for name := range logFormatPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,118 @@
+package fastly
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestLogFormatPresetNames(t *testing.T) {
+	want := []string{"apache_combined", "apache_common", "json_security", "json_standard"}
+	if got := logFormatPresetNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("preset names mismatch, expected: %v, got: %v", want, got)
+	}
+}
+
+func TestLogFormatPresets(t *testing.T) {
+	// The apache presets are identical in both format versions; the JSON
+	// presets need VCL variables only available in version 2.
+	for name, versions := range logFormatPresets {
+		if strings.HasPrefix(name, "apache_") {
+			if versions[1] == "" || versions[1] != versions[2] {
+				t.Errorf("expected preset %s to be identical in both format versions", name)
+			}
+			continue
+		}
+		if _, ok := versions[1]; ok {
+			t.Errorf("expected JSON preset %s to only support format_version 2", name)
+		}
+		if versions[2] == "" {
+			t.Errorf("expected JSON preset %s to have a format_version 2 format", name)
+		}
+	}
+
+	// The JSON presets must produce valid JSON once the VCL placeholders are
+	// substituted; check the object skeleton by replacing each %{...}V (and
+	// any trailing apache-style escapes) with a plain value.
+	placeholder := regexp.MustCompile(`%\{.*?\}V`)
+	for _, name := range []string{"json_standard", "json_security"} {
+		format := logFormatPresets[name][2]
+		skeleton := placeholder.ReplaceAllString(format, "0")
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(skeleton), &decoded); err != nil {
+			t.Errorf("expected preset %s to be a valid JSON skeleton, got error %s in %s", name, err, skeleton)
+		}
+	}
+}
+
+func TestDataSourceFastlyLogFormatRead(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		preset        string
+		formatVersion int
+		wantFormat    string
+		wantError     bool
+	}{
+		"apache common v2":              {preset: "apache_common", formatVersion: 2, wantFormat: `%h %l %u %t "%r" %>s %b`},
+		"apache combined v1":            {preset: "apache_combined", formatVersion: 1, wantFormat: `%h %l %u %t "%r" %>s %b "%{Referer}i" "%{User-Agent}i"`},
+		"json preset":                   {preset: "json_standard", formatVersion: 2, wantFormat: logFormatPresets["json_standard"][2]},
+		"json preset on format_version": {preset: "json_standard", formatVersion: 1, wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceFastlyLogFormat().Schema, map[string]interface{}{
+				"name":           testCase.preset,
+				"format_version": testCase.formatVersion,
+			})
+
+			err := dataSourceFastlyLogFormatRead(d, nil)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				if !strings.Contains(err.Error(), "not available for format_version") {
+					t.Errorf("expected a format_version error, got %q", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			if got := d.Get("format").(string); got != testCase.wantFormat {
+				t.Errorf("format mismatch, expected: %s, got: %s", testCase.wantFormat, got)
+			}
+			if d.Id() == "" {
+				t.Error("expected an ID to be set")
+			}
+		})
+	}
+}
+
+func TestAccDataSourceFastlyLogFormat_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "fastly_log_format" "common" {
+  name = "apache_common"
+}
+
+data "fastly_log_format" "security" {
+  name           = "json_security"
+  format_version = 2
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_log_format.common", "format", `%h %l %u %t "%r" %>s %b`),
+					resource.TestCheckResourceAttrSet("data.fastly_log_format.security", "format"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package fastly
+
+import (
+	"fmt"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceFastlyServiceDiff() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyServiceDiffRead,
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service to diff versions of.",
+			},
+			"from_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version to diff from. Negative numbers count back from the latest version, so `-1` is the latest.",
+			},
+			"to_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version to diff up to. The same rules as `from_version` apply.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "text",
+				Description:  "The format of the returned diff: `text` (default), `html` or `html_simple`.",
+				ValidateFunc: validation.StringInSlice([]string{"text", "html", "html_simple"}, false),
+			},
+			"diff": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The diff of the generated VCL between the two versions, as produced by Fastly. Useful for attaching to pull requests or change tickets.",
+			},
+			"resolved_from_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version number `from_version` resolved to, which differs when a negative selector is used.",
+			},
+			"resolved_to_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version number `to_version` resolved to, which differs when a negative selector is used.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyServiceDiffRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	from := d.Get("from_version").(int)
+	to := d.Get("to_version").(int)
+
+	diff, err := conn.GetDiff(&gofastly.GetDiffInput{
+		ServiceID: serviceID,
+		From:      from,
+		To:        to,
+		Format:    d.Get("format").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up diff for service (%s) from version (%d) to (%d): %s", serviceID, from, to, err)
+	}
+
+	// Negative version selectors resolve server-side; record what the API
+	// actually compared.
+	d.SetId(fmt.Sprintf("%s/%d/%d", serviceID, diff.From, diff.To))
+
+	if err := d.Set("resolved_from_version", diff.From); err != nil {
+		return err
+	}
+	if err := d.Set("resolved_to_version", diff.To); err != nil {
+		return err
+	}
+	if err := d.Set("diff", diff.Diff); err != nil {
+		return err
+	}
+
+	return nil
+}
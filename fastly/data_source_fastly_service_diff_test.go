@@ -0,0 +1,66 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceFastlyServiceDiff_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				// First apply activates version 1; the second apply changes a
+				// versioned attribute so there are two versions to diff.
+				Config: testAccDataSourceFastlyServiceDiffConfig(serviceName, domainName, 3600, false),
+			},
+			{
+				Config: testAccDataSourceFastlyServiceDiffConfig(serviceName, domainName, 300, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_service_diff.diff", "resolved_from_version", "1"),
+					resource.TestCheckResourceAttr("data.fastly_service_diff.diff", "resolved_to_version", "2"),
+					resource.TestCheckResourceAttrSet("data.fastly_service_diff.diff", "diff"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFastlyServiceDiffConfig(serviceName, domain string, defaultTTL int, withDiff bool) string {
+	diffBlock := ""
+	if withDiff {
+		diffBlock = `
+data "fastly_service_diff" "diff" {
+  service_id   = fastly_service_v1.foo.id
+  from_version = 1
+  to_version   = fastly_service_v1.foo.active_version
+}`
+	}
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  default_ttl   = %d
+  force_destroy = true
+}
+%s`, serviceName, domain, defaultTTL, diffBlock)
+}
@@ -0,0 +1,319 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dataSourceFastlyServiceExport renders a service version's configuration as
+// a structured JSON document and, for Compute services, a fastly.toml-style
+// manifest. It exists for audit exports and migration tooling that need the
+// shape of a service without scraping Terraform state.
+func dataSourceFastlyServiceExport() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyServiceExportRead,
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service to export.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the service to export. Defaults to the active version.",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service configuration (domains, backends, snippets, dictionaries, ACLs and logging endpoints) rendered as a JSON document.",
+			},
+			"fastly_toml": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A fastly.toml-compatible manifest for the service's package. Empty for VCL services.",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyServiceExportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+	version := d.Get("version").(int)
+
+	s, err := client.serviceDetails.Get(conn, serviceID)
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up service (%s): %s", serviceID, err)
+	}
+
+	if version == 0 {
+		if s.ActiveVersion.Number == 0 {
+			return fmt.Errorf("[ERR] Service (%s) has no active version, set `version` explicitly to export a draft version", serviceID)
+		}
+		version = s.ActiveVersion.Number
+	}
+
+	export, err := buildServiceExport(conn, s, version)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("[ERR] Error rendering export for service (%s): %s", serviceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", serviceID, version))
+
+	if err := d.Set("version", version); err != nil {
+		return err
+	}
+	if err := d.Set("json", string(rendered)); err != nil {
+		return err
+	}
+
+	manifest := ""
+	if s.Type == ServiceTypeCompute {
+		manifest, err = buildFastlyTOML(conn, s, version)
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("fastly_toml", manifest); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// buildServiceExport gathers the configuration of a service version into a
+// plain map ready for JSON rendering.
+func buildServiceExport(conn *gofastly.Client, s *gofastly.ServiceDetail, version int) (map[string]interface{}, error) {
+	export := map[string]interface{}{
+		"service_id": s.ID,
+		"name":       s.Name,
+		"type":       s.Type,
+		"version":    version,
+	}
+	if s.Comment != "" {
+		export["comment"] = s.Comment
+	}
+
+	domainList, err := conn.ListDomains(&gofastly.ListDomainsInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Domains for (%s), version (%v): %s", s.ID, version, err)
+	}
+	domains := []map[string]interface{}{}
+	for _, domain := range domainList {
+		m := map[string]interface{}{"name": domain.Name}
+		if domain.Comment != "" {
+			m["comment"] = domain.Comment
+		}
+		domains = append(domains, m)
+	}
+	export["domains"] = domains
+
+	backendList, err := conn.ListBackends(&gofastly.ListBackendsInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Backends for (%s), version (%v): %s", s.ID, version, err)
+	}
+	backends := []map[string]interface{}{}
+	for _, backend := range backendList {
+		backends = append(backends, map[string]interface{}{
+			"name":    backend.Name,
+			"address": backend.Address,
+			"port":    int(backend.Port),
+			"use_ssl": backend.UseSSL,
+			"shield":  backend.Shield,
+			"weight":  int(backend.Weight),
+		})
+	}
+	export["backends"] = backends
+
+	snippetList, err := conn.ListSnippets(&gofastly.ListSnippetsInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up VCL Snippets for (%s), version (%v): %s", s.ID, version, err)
+	}
+	snippets := []map[string]interface{}{}
+	for _, snippet := range snippetList {
+		m := map[string]interface{}{
+			"name":     snippet.Name,
+			"type":     string(snippet.Type),
+			"priority": int(snippet.Priority),
+			"dynamic":  snippet.Dynamic == 1,
+		}
+		// Dynamic snippet content lives outside the version and is managed by
+		// other systems; only regular snippet content belongs in the export.
+		if snippet.Dynamic == 0 {
+			m["content"] = snippet.Content
+		}
+		snippets = append(snippets, m)
+	}
+	export["snippets"] = snippets
+
+	dictList, err := conn.ListDictionaries(&gofastly.ListDictionariesInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Dictionaries for (%s), version (%v): %s", s.ID, version, err)
+	}
+	dictionaries := []map[string]interface{}{}
+	for _, dict := range dictList {
+		dictionaries = append(dictionaries, map[string]interface{}{
+			"name":       dict.Name,
+			"id":         dict.ID,
+			"write_only": dict.WriteOnly,
+		})
+	}
+	export["dictionaries"] = dictionaries
+
+	aclList, err := conn.ListACLs(&gofastly.ListACLsInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up ACLs for (%s), version (%v): %s", s.ID, version, err)
+	}
+	acls := []map[string]interface{}{}
+	for _, acl := range aclList {
+		acls = append(acls, map[string]interface{}{
+			"name": acl.Name,
+			"id":   acl.ID,
+		})
+	}
+	export["acls"] = acls
+
+	logging, err := buildLoggingExport(conn, s.ID, version)
+	if err != nil {
+		return nil, err
+	}
+	export["logging"] = logging
+
+	return export, nil
+}
+
+// buildLoggingExport lists the logging endpoints the provider manages (S3,
+// syslog, Kafka), keyed by endpoint type.
+func buildLoggingExport(conn *gofastly.Client, serviceID string, version int) (map[string]interface{}, error) {
+	logging := map[string]interface{}{}
+
+	s3List, err := conn.ListS3s(&gofastly.ListS3sInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up S3 logging for (%s), version (%v): %s", serviceID, version, err)
+	}
+	s3s := []map[string]interface{}{}
+	for _, s3 := range s3List {
+		s3s = append(s3s, map[string]interface{}{
+			"name":   s3.Name,
+			"bucket": s3.BucketName,
+			"domain": s3.Domain,
+		})
+	}
+	logging["s3"] = s3s
+
+	syslogList, err := conn.ListSyslogs(&gofastly.ListSyslogsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Syslog logging for (%s), version (%v): %s", serviceID, version, err)
+	}
+	syslogs := []map[string]interface{}{}
+	for _, syslog := range syslogList {
+		syslogs = append(syslogs, map[string]interface{}{
+			"name":    syslog.Name,
+			"address": syslog.Address,
+			"port":    int(syslog.Port),
+		})
+	}
+	logging["syslog"] = syslogs
+
+	kafkaList, err := conn.ListKafkas(&gofastly.ListKafkasInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERR] Error looking up Kafka logging for (%s), version (%v): %s", serviceID, version, err)
+	}
+	kafkas := []map[string]interface{}{}
+	for _, kafka := range kafkaList {
+		kafkas = append(kafkas, map[string]interface{}{
+			"name":    kafka.Name,
+			"brokers": kafka.Brokers,
+			"topic":   kafka.Topic,
+		})
+	}
+	logging["kafka"] = kafkas
+
+	return logging, nil
+}
+
+// buildFastlyTOML renders a fastly.toml-compatible manifest from a Compute
+// service's package metadata. The format is simple enough that hand-rendering
+// avoids pulling in a TOML dependency.
+func buildFastlyTOML(conn *gofastly.Client, s *gofastly.ServiceDetail, version int) (string, error) {
+	pkg, err := conn.GetPackage(&gofastly.GetPackageInput{
+		ServiceID:      s.ID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			// A Compute service bootstrapped without a package has no
+			// manifest to render.
+			return "", nil
+		}
+		return "", fmt.Errorf("[ERR] Error looking up Package for (%s), version (%v): %s", s.ID, version, err)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "manifest_version = 2\n")
+	name := pkg.Metadata.Name
+	if name == "" {
+		name = s.Name
+	}
+	fmt.Fprintf(&b, "name = %s\n", tomlString(name))
+	if pkg.Metadata.Description != "" {
+		fmt.Fprintf(&b, "description = %s\n", tomlString(pkg.Metadata.Description))
+	}
+	if len(pkg.Metadata.Authors) > 0 {
+		quoted := make([]string, len(pkg.Metadata.Authors))
+		for i, author := range pkg.Metadata.Authors {
+			quoted[i] = tomlString(author)
+		}
+		fmt.Fprintf(&b, "authors = [%s]\n", strings.Join(quoted, ", "))
+	}
+	if pkg.Metadata.Language != "" {
+		fmt.Fprintf(&b, "language = %s\n", tomlString(pkg.Metadata.Language))
+	}
+	fmt.Fprintf(&b, "service_id = %s\n", tomlString(s.ID))
+
+	return b.String(), nil
+}
+
+// tomlString renders a TOML basic string. JSON string escaping is a strict
+// subset of what TOML accepts, so reuse it.
+func tomlString(s string) string {
+	rendered, _ := json.Marshal(s)
+	return string(rendered)
+}
@@ -0,0 +1,142 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestTomlString(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		in   string
+		want string
+	}{
+		"plain":     {in: "my-service", want: `"my-service"`},
+		"empty":     {in: "", want: `""`},
+		"quotes":    {in: `say "hi"`, want: `"say \"hi\""`},
+		"backslash": {in: `a\b`, want: `"a\\b"`},
+		"newline":   {in: "line one\nline two", want: `"line one\nline two"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := tomlString(testCase.in); got != testCase.want {
+				t.Errorf("tomlString mismatch, expected: %s, got: %s", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildFastlyTOML(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/service/full-svc/version/3/package":
+			w.Write([]byte(`{"metadata": {"name": "edge app", "description": "does \"edge\" things", "authors": ["a@example.com", "b@example.com"], "language": "rust"}}`))
+		case "/service/bare-svc/version/1/package":
+			// A package uploaded without manifest metadata; the service name
+			// fills in for the package name.
+			w.Write([]byte(`{"metadata": {}}`))
+		case "/service/no-pkg-svc/version/1/package":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"msg": "Not Found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", server.URL)
+	if err != nil {
+		t.Fatalf("Error building client: %s", err)
+	}
+
+	for name, testCase := range map[string]struct {
+		service *gofastly.ServiceDetail
+		version int
+		want    string
+	}{
+		"full metadata": {
+			service: &gofastly.ServiceDetail{ID: "full-svc", Name: "fallback", Type: ServiceTypeCompute},
+			version: 3,
+			want: `manifest_version = 2
+name = "edge app"
+description = "does \"edge\" things"
+authors = ["a@example.com", "b@example.com"]
+language = "rust"
+service_id = "full-svc"
+`,
+		},
+		"bare metadata": {
+			service: &gofastly.ServiceDetail{ID: "bare-svc", Name: "bare service", Type: ServiceTypeCompute},
+			version: 1,
+			want: `manifest_version = 2
+name = "bare service"
+service_id = "bare-svc"
+`,
+		},
+		"no package": {
+			service: &gofastly.ServiceDetail{ID: "no-pkg-svc", Name: "empty", Type: ServiceTypeCompute},
+			version: 1,
+			want:    "",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := buildFastlyTOML(conn, testCase.service, testCase.version)
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			if got != testCase.want {
+				t.Errorf("manifest mismatch, expected:\n%s\ngot:\n%s", testCase.want, got)
+			}
+		})
+	}
+}
+
+func TestAccDataSourceFastlyServiceExport_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFastlyServiceExportConfig(serviceName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_service_export.export", "version", "1"),
+					resource.TestCheckResourceAttrSet("data.fastly_service_export.export", "json"),
+					// VCL services have no package, so no manifest.
+					resource.TestCheckResourceAttr("data.fastly_service_export.export", "fastly_toml", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFastlyServiceExportConfig(serviceName, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_service_export" "export" {
+  service_id = fastly_service_v1.foo.id
+  version    = fastly_service_v1.foo.active_version
+}`, serviceName, domain)
+}
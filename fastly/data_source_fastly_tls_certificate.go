@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fastly/go-fastly/v3/fastly"
@@ -49,6 +50,22 @@ func dataSourceFastlyTLSCertificate() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"id"},
 			},
+			"domain": {
+				Type:          schema.TypeString,
+				Description:   "A domain to find a certificate covering it. Unlike `domains`, this also matches wildcard certificate entries (`*.example.com` covers `www.example.com`).",
+				Optional:      true,
+				ConflictsWith: []string{"id"},
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate will expire",
+				Computed:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Timestamp (GMT) when the certificate became valid",
+				Computed:    true,
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: "Timestamp (GMT) when the certificate was created",
@@ -145,6 +162,16 @@ func getTLSCertificateFilters(d *schema.ResourceData) []TLSCertificatePredicate
 			return c.Issuer == v.(string)
 		})
 	}
+	if v, ok := d.GetOk("domain"); ok {
+		filters = append(filters, func(c *fastly.CustomTLSCertificate) bool {
+			for _, domain := range c.Domains {
+				if certificateDomainCovers(domain.ID, v.(string)) {
+					return true
+				}
+			}
+			return false
+		})
+	}
 
 	return filters
 }
@@ -209,10 +236,38 @@ func dataSourceFastlyTLSCertificateSetAttributes(certificate *fastly.CustomTLSCe
 	if err := d.Set("domains", domains); err != nil {
 		return err
 	}
+	if certificate.NotAfter != nil {
+		if err := d.Set("not_after", certificate.NotAfter.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
+	if certificate.NotBefore != nil {
+		if err := d.Set("not_before", certificate.NotBefore.Format(time.RFC3339)); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// certificateDomainCovers reports whether a certificate SAN entry covers the
+// given domain, honouring single-level wildcards: `*.example.com` covers
+// `www.example.com` but neither `example.com` nor `a.b.example.com`.
+func certificateDomainCovers(san, domain string) bool {
+	san = normalizeDomainName(san)
+	domain = normalizeDomainName(domain)
+
+	if san == domain {
+		return true
+	}
+	if strings.HasPrefix(san, "*.") {
+		if i := strings.Index(domain, "."); i != -1 && domain[i+1:] == san[2:] {
+			return true
+		}
+	}
+	return false
+}
+
 func filterTLSCertificate(config *fastly.CustomTLSCertificate, filters []TLSCertificatePredicate) bool {
 	for _, f := range filters {
 		if !f(config) {
@@ -9,6 +9,28 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestCertificateDomainCovers(t *testing.T) {
+	cases := []struct {
+		san     string
+		domain  string
+		covered bool
+	}{
+		{"example.com", "example.com", true},
+		{"example.com", "EXAMPLE.COM.", true},
+		{"example.com", "www.example.com", false},
+		{"*.example.com", "www.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "a.b.example.com", false},
+		{"*.example.com", "www.example.org", false},
+	}
+
+	for _, c := range cases {
+		if got := certificateDomainCovers(c.san, c.domain); got != c.covered {
+			t.Errorf("certificateDomainCovers(%q, %q) = %v, want %v", c.san, c.domain, got, c.covered)
+		}
+	}
+}
+
 func TestAccFastlyDataSourceTLSCertificate_withName(t *testing.T) {
 	name := acctest.RandomWithPrefix(testResourcePrefix)
 	domain := fmt.Sprintf("%s.example.com", name)
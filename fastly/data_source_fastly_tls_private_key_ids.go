@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 
+	"github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -12,6 +13,16 @@ func dataSourceFastlyTLSPrivateKeyIDs() *schema.Resource {
 		Read: dataSourceFastlyTLSPrivateKeyIDsRead,
 
 		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Limit the returned keys to those with this name.",
+				Optional:    true,
+			},
+			"public_key_sha1": {
+				Type:        schema.TypeString,
+				Description: "Limit the returned keys to the one whose public key has this SHA-1 hash, to match a certificate to its already-uploaded key.",
+				Optional:    true,
+			},
 			"ids": {
 				Type:        schema.TypeSet,
 				Description: "List of IDs of the TLS private keys.",
@@ -25,7 +36,23 @@ func dataSourceFastlyTLSPrivateKeyIDs() *schema.Resource {
 func dataSourceFastlyTLSPrivateKeyIDsRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*FastlyClient).conn
 
-	keys, err := listTLSPrivateKeys(conn)
+	var filters []TLSPrivateKeyPredicate
+	filterHash := ""
+
+	if v, ok := d.GetOk("name"); ok {
+		filters = append(filters, func(key *fastly.PrivateKey) bool {
+			return key.Name == v.(string)
+		})
+		filterHash += fmt.Sprintf("name=%s;", v.(string))
+	}
+	if v, ok := d.GetOk("public_key_sha1"); ok {
+		filters = append(filters, func(key *fastly.PrivateKey) bool {
+			return key.PublicKeySHA1 == v.(string)
+		})
+		filterHash += fmt.Sprintf("public_key_sha1=%s;", v.(string))
+	}
+
+	keys, err := listTLSPrivateKeys(conn, filters...)
 	if err != nil {
 		return err
 	}
@@ -38,7 +65,7 @@ func dataSourceFastlyTLSPrivateKeyIDsRead(d *schema.ResourceData, meta interface
 	// 2.x upgrade note - `hashcode.String` was removed from the SDK
 	// Code will need to be copied into this repository
 	// https://www.terraform.io/docs/extend/guides/v2-upgrade-guide.html#removal-of-helper-hashcode-package
-	d.SetId(fmt.Sprintf("%d", hashcode.String(""))) // if other filters are added to this data source, they should be included in this hashcode instead of the empty string
+	d.SetId(fmt.Sprintf("%d", hashcode.String(filterHash)))
 	if err := d.Set("ids", ids); err != nil {
 		return err
 	}
@@ -40,7 +40,7 @@ func dataSourceFastlyIPRangesRead(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Reading IP ranges")
 
-	ipv4addresses, ipv6addresses, err := conn.AllIPs()
+	ipv4addresses, ipv6addresses, err := allIPsCached(conn)
 
 	if err != nil {
 		return fmt.Errorf("Error listing IP ranges: %s", err)
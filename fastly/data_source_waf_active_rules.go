@@ -0,0 +1,85 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFastlyWAFActiveRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyWAFActiveRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"waf_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the WAF to list active rules for.",
+			},
+			"waf_version_number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The WAF firewall version to list active rules for.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of rules active on the WAF version, for comparing deployed rules against an intended rule set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"modsec_rule_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The modsecurity rule ID.",
+						},
+						"revision": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The deployed revision of the rule.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The rule's status on this WAF version; one of `log`, `block` or `score`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceFastlyWAFActiveRulesRead(d *schema.ResourceData, meta interface{}) error {
+
+	conn := meta.(*FastlyClient).conn
+
+	wafID := d.Get("waf_id").(string)
+	wafVersionNumber := d.Get("waf_version_number").(int)
+
+	log.Printf("[INFO] Reading active rules for WAF: %s, version: %d", wafID, wafVersionNumber)
+	res, err := conn.ListAllWAFActiveRules(&gofastly.ListAllWAFActiveRulesInput{
+		WAFID:            wafID,
+		WAFVersionNumber: wafVersionNumber,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing WAF active rules: %s", err)
+	}
+
+	rules := make([]map[string]interface{}, len(res.Items))
+	for i, r := range res.Items {
+		rules[i] = map[string]interface{}{
+			"modsec_rule_id": r.ModSecID,
+			"revision":       r.Revision,
+			"status":         r.Status,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", wafID, wafVersionNumber))
+	if err := d.Set("rules", rules); err != nil {
+		return fmt.Errorf("error setting WAF active rules: %s", err)
+	}
+
+	return nil
+}
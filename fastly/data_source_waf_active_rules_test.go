@@ -0,0 +1,50 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccFastlyDataSourceWAFActiveRules_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	rules := []gofastly.WAFActiveRule{
+		{
+			ModSecID: 2029718,
+			Status:   "log",
+			Revision: 1,
+		},
+	}
+
+	wafVer := testAccFastlyServiceWAFVersionV1BuildConfig(20)
+	rulesHCL := testAccCheckFastlyServiceWAFVersionV1ComposeWAFRules(rules)
+	extraHCL := testAccFastlyServiceWAFVersionV1ComposeConfiguration(wafVer, rulesHCL, "") + testAccFastlyDataSourceWAFActiveRules()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyServiceWAFVersionV1(name, extraHCL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.fastly_waf_active_rules.deployed", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.fastly_waf_active_rules.deployed", "rules.0.modsec_rule_id", "2029718"),
+					resource.TestCheckResourceAttr("data.fastly_waf_active_rules.deployed", "rules.0.status", "log"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastlyDataSourceWAFActiveRules() string {
+	return `
+        data "fastly_waf_active_rules" "deployed" {
+          waf_id             = fastly_service_waf_configuration.waf.waf_id
+          waf_version_number = 1
+        }`
+}
@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+)
+
+// debugLoggingTransport dumps API requests and responses to the Terraform log
+// when the log level is DEBUG or higher, in the same way as the SDK logging
+// transport, but with the Fastly-Key authentication header redacted so tokens
+// never end up in debug output.
+type debugLoggingTransport struct {
+	name      string
+	transport http.RoundTripper
+}
+
+func newDebugLoggingTransport(name string, t http.RoundTripper) *debugLoggingTransport {
+	return &debugLoggingTransport{name: name, transport: t}
+}
+
+func (t *debugLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if logging.IsDebugOrHigher() {
+		// Redact the authentication header only in the copy being logged; the
+		// real request goes out untouched.
+		logReq := req.Clone(req.Context())
+		if logReq.Header.Get("Fastly-Key") != "" {
+			logReq.Header.Set("Fastly-Key", "REDACTED")
+		}
+
+		// Clone shallow-copies Body, so dumping the clone would drain the
+		// reader the outgoing request is about to send. Buffer the body once
+		// and give each request its own reader.
+		if req.Body != nil {
+			body, err := ioutil.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+			logReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		reqData, err := httputil.DumpRequestOut(logReq, true)
+		if err == nil {
+			log.Printf("[DEBUG] "+logReqMsg, t.name, string(reqData))
+		} else {
+			log.Printf("[ERROR] %s API Request error: %#v", t.name, err)
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if logging.IsDebugOrHigher() {
+		respData, err := httputil.DumpResponse(resp, true)
+		if err == nil {
+			log.Printf("[DEBUG] "+logRespMsg, t.name, string(respData))
+		} else {
+			log.Printf("[ERROR] %s API Response error: %#v", t.name, err)
+		}
+	}
+
+	return resp, nil
+}
+
+const logReqMsg = `%s API Request Details:
+---[ REQUEST ]---------------------------------------
+%s
+-----------------------------------------------------`
+
+const logRespMsg = `%s API Response Details:
+---[ RESPONSE ]--------------------------------------
+%s
+-----------------------------------------------------`
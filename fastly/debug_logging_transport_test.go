@@ -0,0 +1,35 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugLoggingTransportPreservesRequestBody(t *testing.T) {
+	oldLevel := os.Getenv("TF_LOG")
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Setenv("TF_LOG", oldLevel)
+
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = string(body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newDebugLoggingTransport("Fastly", http.DefaultTransport),
+	}
+
+	resp, err := client.Post(server.URL, "application/x-www-form-urlencoded", strings.NewReader("name=origin&address=a.example.com"))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "name=origin&address=a.example.com", received)
+}
@@ -0,0 +1,14 @@
+package fastly
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// diffSuppressTrailingWhitespace suppresses diffs where the old and new values
+// only differ in trailing whitespace. Log format strings are commonly written
+// as heredocs, which append a newline the API strips, causing an endless diff.
+func diffSuppressTrailingWhitespace(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimRight(old, " \t\r\n") == strings.TrimRight(new, " \t\r\n")
+}
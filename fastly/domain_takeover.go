@@ -0,0 +1,56 @@
+package fastly
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+)
+
+const (
+	// domainTakeoverTimeout bounds how long a domain-conflict retry loop waits
+	// for the other service to release the domain.
+	domainTakeoverTimeout = 2 * time.Minute
+
+	// domainTakeoverInterval is the delay between retries.
+	domainTakeoverInterval = 5 * time.Second
+)
+
+// isDomainConflictError reports whether an API error indicates a domain is
+// still attached to another service.
+func isDomainConflictError(err error) bool {
+	httpErr, ok := err.(*gofastly.HTTPError)
+	if !ok {
+		return false
+	}
+	if httpErr.StatusCode == 409 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "domain") && (strings.Contains(msg, "taken") || strings.Contains(msg, "already in use") || strings.Contains(msg, "owned by"))
+}
+
+// retryDomainConflict runs op, and when allowed retries domain-conflict errors
+// until the timeout elapses. Moving a domain between two services managed in
+// the same run otherwise fails, because Terraform applies the add and the
+// remove as independent resources in arbitrary order: retrying gives the
+// releasing service time to activate its version without the domain.
+func retryDomainConflict(allowed bool, timeout time.Duration, what string, op func() error) error {
+	err := op()
+	if err == nil || !allowed || !isDomainConflictError(err) {
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		log.Printf("[INFO] %s blocked by a domain conflict, retrying in %s: %s", what, domainTakeoverInterval, err)
+		time.Sleep(domainTakeoverInterval)
+
+		err = op()
+		if err == nil || !isDomainConflictError(err) {
+			return err
+		}
+	}
+	return err
+}
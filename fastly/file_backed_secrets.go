@@ -0,0 +1,63 @@
+package fastly
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+)
+
+// backendFileBackedSecrets lists the backend PEM attributes that accept a
+// `*_file` variant. Each has three companion schema attributes: the inline
+// value, `<name>_file` holding a path read at apply time, and
+// `<name>_sha256` used to detect changes to the file without keeping the PEM
+// material itself in state.
+var backendFileBackedSecrets = []string{"ssl_ca_cert", "ssl_client_cert", "ssl_client_key"}
+
+// fileBackedValue resolves an attribute that can be supplied either inline or
+// as a path to a local file read at apply time. Only one of the two forms may
+// be used at once.
+func fileBackedValue(attr, value, file string) (string, error) {
+	if file == "" {
+		return value, nil
+	}
+	if value != "" {
+		return "", fmt.Errorf("only one of %s and %s_file may be set", attr, attr)
+	}
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("error reading %s_file: %s", attr, err)
+	}
+	return string(b), nil
+}
+
+// secretSHA256 returns the hex encoded SHA-256 of a secret, the form kept in
+// state in place of file-backed PEM material.
+func secretSHA256(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// resolveBackendSecretFiles returns a copy of a backend block with any
+// file-backed PEM attributes replaced by the content of their files, ready to
+// be passed to the create/update input builders.
+func resolveBackendSecretFiles(resource map[string]interface{}) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{}, len(resource))
+	for k, v := range resource {
+		resolved[k] = v
+	}
+
+	for _, k := range backendFileBackedSecrets {
+		file, _ := resource[k+"_file"].(string)
+		if file == "" {
+			continue
+		}
+		value, err := fileBackedValue(k, resource[k].(string), file)
+		if err != nil {
+			return nil, fmt.Errorf("backend %q: %s", resource["name"], err)
+		}
+		resolved[k] = value
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,64 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileBackedValue(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "client.key")
+	if err := ioutil.WriteFile(file, []byte("PEM MATERIAL"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if v, err := fileBackedValue("ssl_client_key", "inline", ""); err != nil || v != "inline" {
+		t.Errorf("inline value: got %q, %v", v, err)
+	}
+	if v, err := fileBackedValue("ssl_client_key", "", file); err != nil || v != "PEM MATERIAL" {
+		t.Errorf("file value: got %q, %v", v, err)
+	}
+	if _, err := fileBackedValue("ssl_client_key", "inline", file); err == nil {
+		t.Error("expected an error when both the inline value and the file are set")
+	}
+	if _, err := fileBackedValue("ssl_client_key", "", filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestResolveBackendSecretFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "ca.pem")
+	if err := ioutil.WriteFile(file, []byte("CA PEM"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource := map[string]interface{}{
+		"name":             "origin",
+		"ssl_ca_cert":      "",
+		"ssl_ca_cert_file": file,
+		"ssl_client_cert":  "inline cert",
+		"ssl_client_key":   "",
+	}
+
+	resolved, err := resolveBackendSecretFiles(resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved["ssl_ca_cert"] != "CA PEM" {
+		t.Errorf("ssl_ca_cert: got %q", resolved["ssl_ca_cert"])
+	}
+	if resolved["ssl_client_cert"] != "inline cert" {
+		t.Errorf("ssl_client_cert: got %q", resolved["ssl_client_cert"])
+	}
+	if resource["ssl_ca_cert"] != "" {
+		t.Error("the input map must not be mutated")
+	}
+}
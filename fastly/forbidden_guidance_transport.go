@@ -0,0 +1,58 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+)
+
+// forbiddenGuidanceTransport rewrites 403 Forbidden responses so the error
+// go-fastly builds from them explains what probably went wrong. The API's own
+// 403 body is usually a bare "Forbidden" with no hint of which call failed or
+// why, which makes token-scope problems needlessly hard to diagnose.
+type forbiddenGuidanceTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *forbiddenGuidanceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		body, _ = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+
+	msg, detail := apiErrorMessage(body, "Forbidden")
+	if detail != "" {
+		detail += "; "
+	}
+	detail += forbiddenGuidance(req)
+
+	// Re-encode in the legacy {"msg": ..., "detail": ...} shape that go-fastly
+	// parses into its HTTPError, so the guidance travels with the error through
+	// every call site without any of them changing.
+	rewritten, jsonErr := json.Marshal(map[string]string{"msg": msg, "detail": detail})
+	if jsonErr != nil {
+		// Can't happen for a map of strings, but never eat the response.
+		rewritten = body
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(rewritten))
+	resp.ContentLength = int64(len(rewritten))
+	resp.Header.Set("Content-Type", "application/json")
+	resp.Header.Set("Content-Length", strconv.Itoa(len(rewritten)))
+	return resp, nil
+}
+
+// forbiddenGuidance names the failed call and the likely causes of a 403 from
+// the Fastly API.
+func forbiddenGuidance(req *http.Request) string {
+	return fmt.Sprintf("the Fastly API denied %s %s; this usually means the API token lacks the required scope (managing service configuration needs a token with the global scope), the token is limited to services that do not include this one, or the token belongs to a different customer account than the resource", req.Method, req.URL.Path)
+}
@@ -0,0 +1,55 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForbiddenGuidanceTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"msg": "Forbidden", "detail": "insufficient privileges"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &forbiddenGuidanceTransport{transport: http.DefaultTransport},
+	}
+
+	resp, err := client.Get(server.URL + "/service/abc/version")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "insufficient privileges")
+	assert.Contains(t, string(body), "GET /service/abc/version")
+	assert.Contains(t, string(body), "lacks the required scope")
+}
+
+func TestForbiddenGuidanceTransportLeavesOtherStatusesAlone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"msg": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &forbiddenGuidanceTransport{transport: http.DefaultTransport},
+	}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"msg": "Not Found"}`, string(body))
+}
@@ -0,0 +1,60 @@
+package fastly
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// offlineTransport answers API requests without network access, for running
+// `terraform plan` in CI jobs that must not hold a real API token. Reads are
+// served from JSON fixture files when a fixtures directory is configured, and
+// anything that would change the account is refused outright.
+type offlineTransport struct {
+	// fixturesDir is the directory holding canned responses; empty means no
+	// fixtures, so every read fails with a pointer to `-refresh=false`.
+	fixturesDir string
+}
+
+func (t *offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return nil, fmt.Errorf("offline mode: refusing %s %s; writes to the Fastly API are disabled", req.Method, req.URL.Path)
+	}
+
+	if t.fixturesDir != "" {
+		path := offlineFixturePath(t.fixturesDir, req.URL.Path)
+		body, err := ioutil.ReadFile(path)
+		if err == nil {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(bytes.NewReader(body)),
+				Request:    req,
+			}, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("offline mode: error reading fixture %s: %s", path, err)
+		}
+		return nil, fmt.Errorf("offline mode: no fixture for %s %s (looked for %s); add one, or plan with -refresh=false", req.Method, req.URL.Path, path)
+	}
+
+	return nil, fmt.Errorf("offline mode: cannot serve %s %s without fixtures; set offline_fixtures_dir, or plan with -refresh=false", req.Method, req.URL.Path)
+}
+
+// offlineFixturePath maps a request path to its fixture file: the URL path
+// relative to the fixtures directory, with a .json extension.
+func offlineFixturePath(dir, urlPath string) string {
+	rel := strings.Trim(urlPath, "/")
+	if rel == "" {
+		rel = "index"
+	}
+	return filepath.Join(dir, filepath.FromSlash(rel)+".json")
+}
@@ -0,0 +1,86 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOfflineTransportRejectsWrites(t *testing.T) {
+	transport := &offlineTransport{}
+
+	req, _ := http.NewRequest(http.MethodPost, "https://api.fastly.com/service", strings.NewReader("{}"))
+	_, err := transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error for a POST in offline mode")
+	}
+	if !strings.Contains(err.Error(), "refusing POST /service") {
+		t.Errorf("expected the error to name the refused call, got %q", err)
+	}
+}
+
+func TestOfflineTransportServesFixtures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-offline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fixture := filepath.Join(dir, "service", "abc", "details.json")
+	if err := os.MkdirAll(filepath.Dir(fixture), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(fixture, []byte(`{"id": "abc"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	transport := &offlineTransport{fixturesDir: dir}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.fastly.com/service/abc/details", nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"id": "abc"}` {
+		t.Errorf("expected the fixture body, got %q", body)
+	}
+}
+
+func TestOfflineTransportMissingFixture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-offline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	transport := &offlineTransport{fixturesDir: dir}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.fastly.com/service/missing", nil)
+	_, err = transport.RoundTrip(req)
+	if err == nil {
+		t.Fatal("expected an error for a missing fixture")
+	}
+	if !strings.Contains(err.Error(), "-refresh=false") {
+		t.Errorf("expected the error to suggest -refresh=false, got %q", err)
+	}
+}
+
+func TestOfflineFixturePath(t *testing.T) {
+	got := offlineFixturePath("fixtures", "/service/abc/details")
+	want := filepath.Join("fixtures", "service", "abc", "details.json")
+	if got != want {
+		t.Errorf("offlineFixturePath = %q, want %q", got, want)
+	}
+}
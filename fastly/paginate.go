@@ -0,0 +1,110 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/mitchellh/mapstructure"
+)
+
+// listEntriesPerPage is the page size requested when listing ACL entries and
+// dictionary items. The API caps responses at this size, so anything larger
+// than one page has to be fetched with explicit pagination.
+const listEntriesPerPage = 1000
+
+// listACLEntriesPaginated returns all entries of an ACL, following the API's
+// pagination so ACLs larger than a single page are read completely. The
+// go-fastly client does not expose pagination for this endpoint.
+func listACLEntriesPaginated(conn *gofastly.Client, serviceID, aclID string) ([]*gofastly.ACLEntry, error) {
+	var entries []*gofastly.ACLEntry
+
+	for page := 1; ; page++ {
+		var pageEntries []*gofastly.ACLEntry
+		path := fmt.Sprintf("/service/%s/acl/%s/entries", serviceID, aclID)
+		if err := getPage(conn, path, page, &pageEntries); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, pageEntries...)
+		if len(pageEntries) < listEntriesPerPage {
+			return entries, nil
+		}
+	}
+}
+
+// listDictionaryItemsPaginated returns all items of a dictionary, following
+// the API's pagination so dictionaries larger than a single page are read
+// completely. The go-fastly client does not expose pagination for this
+// endpoint.
+func listDictionaryItemsPaginated(conn *gofastly.Client, serviceID, dictionaryID string) ([]*gofastly.DictionaryItem, error) {
+	var items []*gofastly.DictionaryItem
+
+	for page := 1; ; page++ {
+		var pageItems []*gofastly.DictionaryItem
+		path := fmt.Sprintf("/service/%s/dictionary/%s/items", serviceID, dictionaryID)
+		if err := getPage(conn, path, page, &pageItems); err != nil {
+			return nil, err
+		}
+
+		items = append(items, pageItems...)
+		if len(pageItems) < listEntriesPerPage {
+			return items, nil
+		}
+	}
+}
+
+// getPage fetches one page of a list endpoint and decodes the response into
+// result, mirroring the weakly-typed mapstructure decoding that go-fastly
+// applies to its own responses.
+func getPage(conn *gofastly.Client, path string, page int, result interface{}) error {
+	resp, err := conn.Get(path, &gofastly.RequestOptions{
+		Params: map[string]string{
+			"page":     strconv.Itoa(page),
+			"per_page": strconv.Itoa(listEntriesPerPage),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return decodeAPIResponse(resp.Body, result)
+}
+
+func decodeAPIResponse(body io.Reader, result interface{}) error {
+	var parsed interface{}
+	if err := json.NewDecoder(body).Decode(&parsed); err != nil {
+		return err
+	}
+
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       apiStringToTimeHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           result,
+	})
+	if err != nil {
+		return err
+	}
+	return dec.Decode(parsed)
+}
+
+// apiStringToTimeHookFunc converts the timestamp strings returned by the
+// Fastly API into time.Time values, matching go-fastly's own decoding.
+func apiStringToTimeHookFunc() mapstructure.DecodeHookFunc {
+	return func(f reflect.Type, t reflect.Type, data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.String || t != reflect.TypeOf(time.Time{}) {
+			return data, nil
+		}
+
+		v, err := time.Parse(time.RFC3339, data.(string))
+		if err != nil {
+			return time.Parse("2006-01-02 15:04:05", data.(string))
+		}
+		return v, nil
+	}
+}
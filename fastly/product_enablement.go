@@ -0,0 +1,120 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The products API (Bot Management, DDoS Protection, Fanout, WebSockets, …)
+// is not covered by the vendored go-fastly client, so these helpers drive it
+// through the client's raw request methods. Products are enabled per service
+// and are not tied to a service version, so no version cloning or activation
+// is involved.
+
+func productPath(productID, serviceID string) string {
+	return fmt.Sprintf("/enabled-products/v1/%s/services/%s", productID, serviceID)
+}
+
+func enableProduct(conn *gofastly.Client, productID, serviceID string) error {
+	resp, err := conn.Put(productPath(productID, serviceID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func disableProduct(conn *gofastly.Client, productID, serviceID string) error {
+	resp, err := conn.Delete(productPath(productID, serviceID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// productEnabled reports whether a product is enabled on a service; the API
+// answers 404 for a disabled product.
+func productEnabled(conn *gofastly.Client, productID, serviceID string) (bool, error) {
+	resp, err := conn.Get(productPath(productID, serviceID), nil)
+	if err != nil {
+		if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.IsNotFound() {
+			return false, nil
+		}
+		return false, err
+	}
+	defer resp.Body.Close()
+	return true, nil
+}
+
+// resourceProductEnablement builds a resource that represents a product being
+// enabled on a service: creating the resource enables the product, destroying
+// it disables it, and the resource drops out of state if the product is
+// disabled out of band. productID is the product's API identifier, e.g.
+// "bot_management".
+func resourceProductEnablement(productID string) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			conn := meta.(*FastlyClient).conn
+			serviceID := d.Get("service_id").(string)
+
+			log.Printf("[DEBUG] Enabling product %s for service (%s)", productID, serviceID)
+			if err := enableProduct(conn, productID, serviceID); err != nil {
+				return fmt.Errorf("Error enabling %s for service %s: %s", productID, serviceID, err)
+			}
+
+			d.SetId(serviceID)
+			return nil
+		},
+
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			conn := meta.(*FastlyClient).conn
+			serviceID := d.Get("service_id").(string)
+
+			enabled, err := productEnabled(conn, productID, serviceID)
+			if err != nil {
+				return fmt.Errorf("Error checking %s for service %s: %s", productID, serviceID, err)
+			}
+			if !enabled {
+				log.Printf("[WARN] Product %s no longer enabled for service (%s), removing from state", productID, serviceID)
+				d.SetId("")
+			}
+			return nil
+		},
+
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			conn := meta.(*FastlyClient).conn
+			serviceID := d.Get("service_id").(string)
+
+			log.Printf("[DEBUG] Disabling product %s for service (%s)", productID, serviceID)
+			if err := disableProduct(conn, productID, serviceID); err != nil {
+				if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.IsNotFound() {
+					return nil
+				}
+				return fmt.Errorf("Error disabling %s for service %s: %s", productID, serviceID, err)
+			}
+			return nil
+		},
+
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("service_id", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Service to enable the product on",
+			},
+		},
+	}
+}
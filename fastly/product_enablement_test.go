@@ -0,0 +1,102 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestProductPath(t *testing.T) {
+	got := productPath("bot_management", "svc123")
+	want := "/enabled-products/v1/bot_management/services/svc123"
+	if got != want {
+		t.Errorf("product path mismatch, expected: %s, got: %s", want, got)
+	}
+}
+
+func TestProductEnabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case productPath("fanout", "enabled-svc"):
+			w.Write([]byte(`{"product": {"id": "fanout"}}`))
+		case productPath("fanout", "disabled-svc"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"msg": "Not Found"}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", server.URL)
+	if err != nil {
+		t.Fatalf("Error building client: %s", err)
+	}
+
+	enabled, err := productEnabled(conn, "fanout", "enabled-svc")
+	if err != nil {
+		t.Fatalf("expected no error for an enabled product, got %q", err)
+	}
+	if !enabled {
+		t.Error("expected the product to report enabled")
+	}
+
+	// A disabled product answers 404, which is a state, not an error.
+	enabled, err = productEnabled(conn, "fanout", "disabled-svc")
+	if err != nil {
+		t.Fatalf("expected no error for a disabled product, got %q", err)
+	}
+	if enabled {
+		t.Error("expected the product to report disabled")
+	}
+}
+
+// Bot Management requires the product to be available on the account, so this
+// is only exercised on suitably entitled accounts.
+func TestAccFastlyServiceBotManagement_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceBotManagementConfig(serviceName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fastly_service_bot_management.bots", "service_id", "fastly_service_v1.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "fastly_service_bot_management.bots",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServiceBotManagementConfig(serviceName, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_service_bot_management" "bots" {
+  service_id = fastly_service_v1.foo.id
+}`, serviceName, domain)
+}
@@ -15,6 +15,7 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_KEY", nil),
 				Description: "Fastly API Key from https://app.fastly.com/#account",
+				Sensitive:   true,
 			},
 			"base_url": {
 				Type:        schema.TypeString,
@@ -22,9 +23,63 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_URL", gofastly.DefaultEndpoint),
 				Description: "Fastly API URL",
 			},
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_HTTP_PROXY", nil),
+				Description: "URL of a proxy to route Fastly API requests through. When unset, the standard proxy environment variables apply",
+			},
+			"ca_cert_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_CA_CERT_FILE", nil),
+				Description: "Path to a PEM bundle of CA certificates appended to the system roots when verifying the Fastly API endpoint",
+			},
+			"validate_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the API token and its scope when the provider is configured, failing fast instead of part-way through an apply. Default `false`",
+			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_USER_AGENT_SUFFIX", nil),
+				Description: "Suffix appended to the User-Agent header on Fastly API requests",
+			},
+			"api_telemetry_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_API_TELEMETRY_FILE", nil),
+				Description: "Path of a JSON file to which a per-run summary of Fastly API calls, grouped by endpoint and status, is written. Unset disables telemetry",
+			},
+			"offline": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_OFFLINE", false),
+				Description: "Run without access to the Fastly API: reads are served from `offline_fixtures_dir` and writes are refused, so CI can plan without a real token (typically with `-refresh=false`). Default `false`",
+			},
+			"offline_fixtures_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_OFFLINE_FIXTURES_DIR", nil),
+				Description: "Directory of canned JSON responses served for reads in offline mode, laid out as the API path with a `.json` extension (e.g. `service/<id>/details.json`)",
+			},
+			"max_api_concurrency": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum number of concurrent requests to the Fastly API. Default `0` (no limit)",
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"fastly_acl_entries":                  dataSourceFastlyACLEntries(),
+			"fastly_dictionary_items":             dataSourceFastlyDictionaryItems(),
+			"fastly_generated_vcl":                dataSourceFastlyGeneratedVCL(),
 			"fastly_ip_ranges":                    dataSourceFastlyIPRanges(),
+			"fastly_log_format":                   dataSourceFastlyLogFormat(),
+			"fastly_service_diff":                 dataSourceFastlyServiceDiff(),
+			"fastly_service_export":               dataSourceFastlyServiceExport(),
 			"fastly_tls_activation":               dataSourceFastlyTLSActivation(),
 			"fastly_tls_activation_ids":           dataSourceFastlyTLSActivationIds(),
 			"fastly_tls_certificate":              dataSourceFastlyTLSCertificate(),
@@ -38,15 +93,28 @@ func Provider() terraform.ResourceProvider {
 			"fastly_tls_private_key_ids":          dataSourceFastlyTLSPrivateKeyIDs(),
 			"fastly_tls_subscription":             dataSourceFastlyTLSSubscription(),
 			"fastly_tls_subscription_ids":         dataSourceFastlyTLSSubscriptionIDs(),
+			"fastly_waf_active_rules":             dataSourceFastlyWAFActiveRules(),
 			"fastly_waf_rules":                    dataSourceFastlyWAFRules(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"fastly_service_v1":                         resourceServiceV1(),
+			"fastly_service_vcl":                        resourceServiceVCL(),
 			"fastly_service_compute":                    resourceServiceComputeV1(),
 			"fastly_service_acl_entries_v1":             resourceServiceAclEntriesV1(),
+			"fastly_service_backend":                    resourceServiceBackend(),
 			"fastly_service_dictionary_items_v1":        resourceServiceDictionaryItemsV1(),
+			"fastly_service_domain":                     resourceServiceDomain(),
 			"fastly_service_dynamic_snippet_content_v1": resourceServiceDynamicSnippetContentV1(),
+			"fastly_service_snippet":                    resourceServiceSnippet(),
 			"fastly_service_waf_configuration":          resourceServiceWAFConfigurationV1(),
+			"fastly_service_bot_management":             resourceServiceBotManagement(),
+			"fastly_service_ddos_protection":            resourceServiceDDoSProtection(),
+			"fastly_service_fanout":                     resourceServiceFanout(),
+			"fastly_service_websockets":                 resourceServiceWebSockets(),
+			"fastly_kvstore_seed":                       resourceKVStoreSeed(),
+			"fastly_logging_kafka":                      resourceLoggingKafka(),
+			"fastly_logging_s3":                         resourceLoggingS3(),
+			"fastly_logging_syslog":                     resourceLoggingSyslog(),
 			"fastly_tls_activation":                     resourceFastlyTLSActivation(),
 			"fastly_tls_certificate":                    resourceFastlyTLSCertificate(),
 			"fastly_tls_private_key":                    resourceFastlyTLSPrivateKey(),
@@ -72,9 +140,17 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
-		ApiKey:           d.Get("api_key").(string),
-		BaseURL:          d.Get("base_url").(string),
-		terraformVersion: terraformVersion,
+		ApiKey:             d.Get("api_key").(string),
+		BaseURL:            d.Get("base_url").(string),
+		MaxAPIConcurrency:  d.Get("max_api_concurrency").(int),
+		HTTPProxy:          d.Get("http_proxy").(string),
+		CACertFile:         d.Get("ca_cert_file").(string),
+		UserAgentSuffix:    d.Get("user_agent_suffix").(string),
+		ValidateToken:      d.Get("validate_token").(bool),
+		APITelemetryFile:   d.Get("api_telemetry_file").(string),
+		Offline:            d.Get("offline").(bool),
+		OfflineFixturesDir: d.Get("offline_fixtures_dir").(string),
+		terraformVersion:   terraformVersion,
 	}
 	return config.Client()
 }
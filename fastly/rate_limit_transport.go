@@ -0,0 +1,95 @@
+package fastly
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// rateLimitMaxRetries is how many times a single request is retried after
+	// receiving a 429 before the error is returned to the caller.
+	rateLimitMaxRetries = 3
+
+	// rateLimitDefaultBackoff is used when the response carries no usable
+	// rate-limit headers.
+	rateLimitDefaultBackoff = 5 * time.Second
+
+	// rateLimitMaxBackoff caps the time spent waiting on a single retry so a
+	// far-future reset timestamp cannot stall an apply indefinitely.
+	rateLimitMaxBackoff = time.Minute
+)
+
+// rateLimitRetryTransport retries requests that are rejected with
+// 429 Too Many Requests, waiting out the interval advertised by the
+// Retry-After or Fastly-RateLimit-Reset response headers.
+type rateLimitRetryTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *rateLimitRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Buffer the request body (they are small API payloads) so the request
+	// can be replayed on retry.
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= rateLimitMaxRetries {
+			return resp, err
+		}
+
+		wait := rateLimitBackoff(resp)
+		resp.Body.Close()
+
+		log.Printf("[WARN] Fastly API rate limited %s %s, retrying in %s", req.Method, req.URL.Path, wait)
+		time.Sleep(wait)
+	}
+}
+
+// rateLimitBackoff determines how long to wait before retrying a rate-limited
+// request, honoring the Retry-After header (seconds or HTTP date) and the
+// Fastly-RateLimit-Reset header (unix epoch of the next window).
+func rateLimitBackoff(resp *http.Response) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+			return capBackoff(time.Duration(secs) * time.Second)
+		}
+		if when, err := http.ParseTime(v); err == nil {
+			if d := time.Until(when); d > 0 {
+				return capBackoff(d)
+			}
+		}
+	}
+
+	if v := resp.Header.Get("Fastly-RateLimit-Reset"); v != "" {
+		if epoch, err := strconv.ParseInt(v, 10, 64); err == nil {
+			if d := time.Until(time.Unix(epoch, 0)); d > 0 {
+				return capBackoff(d)
+			}
+		}
+	}
+
+	return rateLimitDefaultBackoff
+}
+
+func capBackoff(d time.Duration) time.Duration {
+	if d > rateLimitMaxBackoff {
+		return rateLimitMaxBackoff
+	}
+	return d
+}
@@ -0,0 +1,103 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestKVSeedEntriesFromJSONL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-kvseed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "entries.jsonl")
+	jsonl := `{"key": "config/edge.json", "value": "{\"flag\": true}", "metadata": "application/json"}
+
+{"key": "greeting", "value": "hello"}
+{"key": "blob.bin", "value_base64": "AAEC"}
+`
+	if err := ioutil.WriteFile(file, []byte(jsonl), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := kvSeedEntries(file, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	if entries["greeting"].Value != "hello" {
+		t.Errorf("greeting: got %q", entries["greeting"].Value)
+	}
+	if entries["config/edge.json"].Value != `{"flag": true}` {
+		t.Errorf("config/edge.json: got %q", entries["config/edge.json"].Value)
+	}
+	if entries["config/edge.json"].Metadata != "application/json" {
+		t.Errorf("config/edge.json metadata: got %q", entries["config/edge.json"].Metadata)
+	}
+	if entries["blob.bin"].Value != "\x00\x01\x02" {
+		t.Errorf("blob.bin: got %q", entries["blob.bin"].Value)
+	}
+
+	for name, line := range map[string]string{
+		"entry without a key":        `{"value": "no key"}`,
+		"both value and base64":      `{"key": "k", "value": "a", "value_base64": "YQ=="}`,
+		"invalid base64":             `{"key": "k", "value_base64": "not base64!"}`,
+		"duplicate key across lines": "{\"key\": \"k\", \"value\": \"a\"}\n{\"key\": \"k\", \"value\": \"b\"}",
+	} {
+		if err := ioutil.WriteFile(file, []byte(line), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := kvSeedEntries(file, ""); err == nil {
+			t.Errorf("expected an error for %s", name)
+		}
+	}
+}
+
+func TestKVSeedEntriesFromDirectory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fastly-kvseed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "assets"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html/>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "assets", "app.wasm"), []byte{0x00, 0x61, 0x73, 0x6d}, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := kvSeedEntries("", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries["assets/app.wasm"].Value != "\x00asm" {
+		t.Errorf("assets/app.wasm: got %q", entries["assets/app.wasm"].Value)
+	}
+
+	if _, err := kvSeedEntries("", ""); err == nil {
+		t.Error("expected an error when no source is set")
+	}
+}
+
+func TestKVSeedEntriesHash(t *testing.T) {
+	a := kvSeedEntriesHash(map[string]kvSeedEntry{"a": {Value: "1"}, "b": {Value: "2"}})
+	b := kvSeedEntriesHash(map[string]kvSeedEntry{"b": {Value: "2"}, "a": {Value: "1"}})
+	if a != b {
+		t.Error("hash must not depend on map iteration order")
+	}
+	if a == kvSeedEntriesHash(map[string]kvSeedEntry{"a": {Value: "1"}, "b": {Value: "3"}}) {
+		t.Error("hash must change when a value changes")
+	}
+	if a == kvSeedEntriesHash(map[string]kvSeedEntry{"a": {Value: "1"}, "b": {Value: "2", Metadata: "text/plain"}}) {
+		t.Error("hash must change when metadata changes")
+	}
+}
@@ -0,0 +1,207 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// standaloneLoggingEndpoint describes one logging endpoint type offered as a
+// standalone resource in addition to its nested block on the service
+// resource. The scaffold in resourceStandaloneLogging supplies the shared
+// CRUD flow (service lock, version clone, validation, activation, import by
+// service ID and name); each endpoint supplies its schema and the calls that
+// map attributes onto the go-fastly inputs.
+//
+// A logging endpoint must not be managed both as a standalone resource and in
+// the corresponding block of the service resource, or the two will fight over
+// it.
+type standaloneLoggingEndpoint struct {
+	// key is the endpoint's block name on the service resource, e.g. "s3",
+	// used in log and error messages.
+	key string
+
+	// attributes holds the endpoint-specific schema. The scaffold adds
+	// service_id, name and activate.
+	attributes map[string]*schema.Schema
+
+	create func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error
+	update func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error
+	delete func(conn *gofastly.Client, serviceID string, version int, name string) error
+
+	// read refreshes the endpoint-specific attributes, returning the raw API
+	// error (including 404s) for the scaffold to interpret.
+	read func(conn *gofastly.Client, serviceID string, version int, name string, d *schema.ResourceData) error
+}
+
+func resourceStandaloneLogging(e standaloneLoggingEndpoint) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"service_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The ID of the Service that the logging endpoint belongs to",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "A unique name to identify this logging endpoint. It is important to note that changing this attribute will delete and recreate the resource",
+		},
+		"activate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to activate the new service version after a change to this logging endpoint. Default `true`",
+		},
+	}
+	for k, v := range e.attributes {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return standaloneLoggingCreate(d, meta, e)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return standaloneLoggingRead(d, meta, e)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return standaloneLoggingUpdate(d, meta, e)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return standaloneLoggingDelete(d, meta, e)
+		},
+		Importer: &schema.ResourceImporter{
+			State: standaloneLoggingImport,
+		},
+		Schema: s,
+	}
+}
+
+func standaloneLoggingCreate(d *schema.ResourceData, meta interface{}, e standaloneLoggingEndpoint) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		log.Printf("[DEBUG] Creating %s logging endpoint (%s) for service (%s), version (%d)", e.key, name, serviceID, version)
+		return e.create(conn, serviceID, version, d)
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating %s logging endpoint: service %s, endpoint %s, %s", e.key, serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, name))
+	return standaloneLoggingRead(d, meta, e)
+}
+
+func standaloneLoggingRead(d *schema.ResourceData, meta interface{}, e standaloneLoggingEndpoint) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	name := d.Get("name").(string)
+
+	version, err := readServiceVersion(conn, serviceID, d.Get("activate").(bool))
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Fastly Service (%s): %s", serviceID, err)
+	}
+
+	log.Printf("[DEBUG] Refreshing %s logging endpoint (%s) for (%s), version (%d)", e.key, name, serviceID, version)
+	if err := e.read(conn, serviceID, version, name, d); err != nil {
+		if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.IsNotFound() {
+			log.Printf("[WARN] %s logging endpoint (%s) not found for service (%s), removing from state", e.key, name, serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+func standaloneLoggingUpdate(d *schema.ResourceData, meta interface{}, e standaloneLoggingEndpoint) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		log.Printf("[DEBUG] Updating %s logging endpoint (%s) for service (%s), version (%d)", e.key, name, serviceID, version)
+		return e.update(conn, serviceID, version, d)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating %s logging endpoint: service %s, endpoint %s, %s", e.key, serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	return standaloneLoggingRead(d, meta, e)
+}
+
+func standaloneLoggingDelete(d *schema.ResourceData, meta interface{}, e standaloneLoggingEndpoint) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		log.Printf("[DEBUG] Removing %s logging endpoint (%s) for service (%s), version (%d)", e.key, name, serviceID, version)
+		err := e.delete(conn, serviceID, version, name)
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting %s logging endpoint: service %s, endpoint %s, %s", e.key, serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId("")
+	return nil
+}
+
+func standaloneLoggingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.SplitN(d.Id(), "/", 2)
+
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[endpoint_name]", d.Id())
+	}
+
+	if err := d.Set("service_id", split[0]); err != nil {
+		return nil, fmt.Errorf("Error importing logging endpoint: service %s, endpoint %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("name", split[1]); err != nil {
+		return nil, fmt.Errorf("Error importing logging endpoint: service %s, endpoint %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("activate", true); err != nil {
+		return nil, fmt.Errorf("Error importing logging endpoint: service %s, endpoint %s, %s", split[0], split[1], err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
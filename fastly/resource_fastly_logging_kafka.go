@@ -0,0 +1,262 @@
+package fastly
+
+import (
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceLoggingKafka manages a single Kafka logging endpoint on a service
+// as a standalone resource. See resourceStandaloneLogging for the shared
+// lifecycle; the attributes mirror the `logging_kafka` block of the service
+// resource.
+func resourceLoggingKafka() *schema.Resource {
+	return resourceStandaloneLogging(standaloneLoggingEndpoint{
+		key: "logging_kafka",
+		attributes: map[string]*schema.Schema{
+			"topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kafka topic to send logs to",
+			},
+			"brokers": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A comma-separated list of IP addresses or hostnames of Kafka brokers",
+				ValidateFunc: validateKafkaBrokers(),
+			},
+			"compression_codec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The codec used for compression of your logs. One of: `gzip`, `snappy`, `lz4`",
+				ValidateFunc: validateKafkaCompressionCodec(),
+			},
+			"required_acks": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "1",
+				Description:  "The Number of acknowledgements a leader must receive before a write is considered successful. One of: `1` (default) One server needs to respond. `0` No servers need to respond. `-1` Wait for all in-sync replicas to respond",
+				ValidateFunc: validateKafkaRequiredACKs(),
+			},
+			"use_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to use TLS for secure logging. Can be either `true` or `false`",
+			},
+			"tls_ca_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "A secure certificate to authenticate the server with. Must be in PEM format",
+				Sensitive:    true,
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"tls_client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The client certificate used to make authenticated requests. Must be in PEM format",
+				Sensitive:    true,
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"tls_client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The client private key used to make authenticated requests. Must be in PEM format",
+				Sensitive:    true,
+				ValidateFunc: validatePEMPrivateKey(),
+			},
+			"tls_hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The hostname used to verify the server's certificate. It can either be the Common Name or a Subject Alternative Name (SAN)",
+			},
+			"parse_log_keyvals": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables parsing of key=value tuples from the beginning of a logline, turning them into record headers",
+			},
+			"request_max_bytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum size of log batch, if non-zero. Defaults to 0 for unbounded",
+			},
+			"auth_method": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SASL authentication method. One of: plain, scram-sha-256, scram-sha-512",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SASL User",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SASL Pass",
+				Sensitive:   true,
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "%h %l %u %t \"%r\" %>s %b",
+				Description:      "Apache style log formatting.",
+				DiffSuppressFunc: diffSuppressTrailingWhitespace,
+				ValidateFunc:     validateLogFormat(),
+			},
+			"format_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "The version of the custom logging format used for the configured endpoint. Can be either 1 or 2. (default: 2).",
+				ValidateFunc: validateLoggingFormatVersion(),
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of an existing condition in the configured endpoint, or leave blank to always execute.",
+			},
+			"placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Where in the generated VCL the logging call should be placed.",
+				ValidateFunc: validateLoggingPlacement(),
+			},
+		},
+
+		create: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			_, err := conn.CreateKafka(&gofastly.CreateKafkaInput{
+				ServiceID:         serviceID,
+				ServiceVersion:    version,
+				Name:              d.Get("name").(string),
+				Brokers:           d.Get("brokers").(string),
+				Topic:             d.Get("topic").(string),
+				RequiredACKs:      d.Get("required_acks").(string),
+				UseTLS:            gofastly.Compatibool(d.Get("use_tls").(bool)),
+				CompressionCodec:  d.Get("compression_codec").(string),
+				TLSCACert:         d.Get("tls_ca_cert").(string),
+				TLSClientCert:     d.Get("tls_client_cert").(string),
+				TLSClientKey:      d.Get("tls_client_key").(string),
+				TLSHostname:       d.Get("tls_hostname").(string),
+				Format:            d.Get("format").(string),
+				FormatVersion:     uint(d.Get("format_version").(int)),
+				ResponseCondition: d.Get("response_condition").(string),
+				Placement:         d.Get("placement").(string),
+				ParseLogKeyvals:   gofastly.Compatibool(d.Get("parse_log_keyvals").(bool)),
+				RequestMaxBytes:   uint(d.Get("request_max_bytes").(int)),
+				AuthMethod:        d.Get("auth_method").(string),
+				User:              d.Get("user").(string),
+				Password:          d.Get("password").(string),
+			})
+			return err
+		},
+
+		update: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			opts := gofastly.UpdateKafkaInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           d.Get("name").(string),
+			}
+
+			// Only attempt to update attributes that have changed.
+			if d.HasChange("brokers") {
+				opts.Brokers = gofastly.String(d.Get("brokers").(string))
+			}
+			if d.HasChange("topic") {
+				opts.Topic = gofastly.String(d.Get("topic").(string))
+			}
+			if d.HasChange("required_acks") {
+				opts.RequiredACKs = gofastly.String(d.Get("required_acks").(string))
+			}
+			if d.HasChange("use_tls") {
+				opts.UseTLS = gofastly.CBool(d.Get("use_tls").(bool))
+			}
+			if d.HasChange("compression_codec") {
+				opts.CompressionCodec = gofastly.String(d.Get("compression_codec").(string))
+			}
+			if d.HasChange("tls_ca_cert") {
+				opts.TLSCACert = gofastly.String(d.Get("tls_ca_cert").(string))
+			}
+			if d.HasChange("tls_client_cert") {
+				opts.TLSClientCert = gofastly.String(d.Get("tls_client_cert").(string))
+			}
+			if d.HasChange("tls_client_key") {
+				opts.TLSClientKey = gofastly.String(d.Get("tls_client_key").(string))
+			}
+			if d.HasChange("tls_hostname") {
+				opts.TLSHostname = gofastly.String(d.Get("tls_hostname").(string))
+			}
+			if d.HasChange("parse_log_keyvals") {
+				opts.ParseLogKeyvals = gofastly.CBool(d.Get("parse_log_keyvals").(bool))
+			}
+			if d.HasChange("request_max_bytes") {
+				opts.RequestMaxBytes = gofastly.Uint(uint(d.Get("request_max_bytes").(int)))
+			}
+			if d.HasChange("auth_method") {
+				opts.AuthMethod = gofastly.String(d.Get("auth_method").(string))
+			}
+			if d.HasChange("user") {
+				opts.User = gofastly.String(d.Get("user").(string))
+			}
+			if d.HasChange("password") {
+				opts.Password = gofastly.String(d.Get("password").(string))
+			}
+			if d.HasChange("format") {
+				opts.Format = gofastly.String(d.Get("format").(string))
+			}
+			if d.HasChange("format_version") {
+				opts.FormatVersion = gofastly.Uint(uint(d.Get("format_version").(int)))
+			}
+			if d.HasChange("response_condition") {
+				opts.ResponseCondition = gofastly.String(d.Get("response_condition").(string))
+			}
+			if d.HasChange("placement") {
+				opts.Placement = gofastly.String(d.Get("placement").(string))
+			}
+
+			_, err := conn.UpdateKafka(&opts)
+			return err
+		},
+
+		delete: func(conn *gofastly.Client, serviceID string, version int, name string) error {
+			return conn.DeleteKafka(&gofastly.DeleteKafkaInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+		},
+
+		read: func(conn *gofastly.Client, serviceID string, version int, name string, d *schema.ResourceData) error {
+			k, err := conn.GetKafka(&gofastly.GetKafkaInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+			if err != nil {
+				return err
+			}
+
+			d.Set("brokers", k.Brokers)
+			d.Set("topic", k.Topic)
+			d.Set("required_acks", k.RequiredACKs)
+			d.Set("use_tls", k.UseTLS)
+			d.Set("compression_codec", k.CompressionCodec)
+			d.Set("tls_ca_cert", k.TLSCACert)
+			d.Set("tls_client_cert", k.TLSClientCert)
+			d.Set("tls_client_key", k.TLSClientKey)
+			d.Set("tls_hostname", k.TLSHostname)
+			d.Set("parse_log_keyvals", k.ParseLogKeyvals)
+			d.Set("request_max_bytes", int(k.RequestMaxBytes))
+			d.Set("auth_method", k.AuthMethod)
+			d.Set("user", k.User)
+			d.Set("password", k.Password)
+			d.Set("format", k.Format)
+			d.Set("format_version", int(k.FormatVersion))
+			d.Set("response_condition", k.ResponseCondition)
+			d.Set("placement", k.Placement)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,265 @@
+package fastly
+
+import (
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceLoggingS3 manages a single S3 logging endpoint on a service as a
+// standalone resource. See resourceStandaloneLogging for the shared
+// lifecycle; the attributes mirror the `s3logging` block of the service
+// resource.
+func resourceLoggingS3() *schema.Resource {
+	return resourceStandaloneLogging(standaloneLoggingEndpoint{
+		key: "s3logging",
+		attributes: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket in which to store the logs",
+			},
+			"s3_access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_S3_ACCESS_KEY", ""),
+				Description: "AWS Access Key of an account with the required permissions to post logs. It is **strongly** recommended you create a separate IAM user with permissions to only operate on this Bucket. This key will be not be encrypted. You can provide this key via an environment variable, `FASTLY_S3_ACCESS_KEY`",
+				Sensitive:   true,
+			},
+			"s3_secret_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("FASTLY_S3_SECRET_KEY", ""),
+				Description: "AWS Secret Key of an account with the required permissions to post logs. It is **strongly** recommended you create a separate IAM user with permissions to only operate on this Bucket. This secret will be not be encrypted. You can provide this secret via an environment variable, `FASTLY_S3_SECRET_KEY`",
+				Sensitive:   true,
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to store the files. Must end with a trailing slash. If this field is left empty, the files will be saved in the bucket's root path",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If you created the S3 bucket outside of `us-east-1`, then specify the corresponding bucket endpoint. Example: `s3-us-west-2.amazonaws.com`",
+				Default:     "s3.amazonaws.com",
+			},
+			"gzip_level": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Level of Gzip compression, from `0-9`. `0` is no compression. `1` is fastest and least compressed, `9` is slowest and most compressed. Default `0`",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "How frequently the logs should be transferred, in seconds. Default `3600`",
+			},
+			"timestamp_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "%Y-%m-%dT%H:%M:%S.000",
+				Description: "`strftime` specified timestamp formatting (default `%Y-%m-%dT%H:%M:%S.000`)",
+			},
+			"redundancy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The S3 redundancy level. Should be formatted; one of: `standard`, `reduced_redundancy` or null. Default `null`",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A PGP public key that Fastly will use to encrypt your log files before writing them to disk",
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "classic",
+				Description:  "How the message should be formatted; one of: `classic`, `loggly`, `logplex` or `blank`. Default `classic`",
+				ValidateFunc: validateLoggingMessageType(),
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Specify what type of server side encryption should be used. Can be either `AES256` or `aws:kms`",
+				ValidateFunc: validateLoggingServerSideEncryption(),
+			},
+			"server_side_encryption_kms_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional server-side KMS Key Id. Must be set if server_side_encryption is set to `aws:kms`",
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "%h %l %u %t %r %>s",
+				Description:      "Apache-style string or VCL variables to use for log formatting.",
+				DiffSuppressFunc: diffSuppressTrailingWhitespace,
+				ValidateFunc:     validateLogFormat(),
+			},
+			"format_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "The version of the custom logging format used for the configured endpoint. Can be either 1 or 2. (Default: 2).",
+				ValidateFunc: validateLoggingFormatVersion(),
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of a condition to apply this logging.",
+			},
+			"placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Where in the generated VCL the logging call should be placed.",
+				ValidateFunc: validateLoggingPlacement(),
+			},
+		},
+
+		create: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			opts := gofastly.CreateS3Input{
+				ServiceID:                    serviceID,
+				ServiceVersion:               version,
+				Name:                         d.Get("name").(string),
+				BucketName:                   d.Get("bucket_name").(string),
+				AccessKey:                    d.Get("s3_access_key").(string),
+				SecretKey:                    d.Get("s3_secret_key").(string),
+				Period:                       uint(d.Get("period").(int)),
+				GzipLevel:                    uint(d.Get("gzip_level").(int)),
+				Domain:                       d.Get("domain").(string),
+				Path:                         d.Get("path").(string),
+				TimestampFormat:              d.Get("timestamp_format").(string),
+				MessageType:                  d.Get("message_type").(string),
+				PublicKey:                    d.Get("public_key").(string),
+				ServerSideEncryptionKMSKeyID: d.Get("server_side_encryption_kms_key_id").(string),
+				Format:                       d.Get("format").(string),
+				FormatVersion:                uint(d.Get("format_version").(int)),
+				ResponseCondition:            d.Get("response_condition").(string),
+				Placement:                    d.Get("placement").(string),
+			}
+
+			switch strings.ToLower(d.Get("redundancy").(string)) {
+			case "standard":
+				opts.Redundancy = gofastly.S3RedundancyStandard
+			case "reduced_redundancy":
+				opts.Redundancy = gofastly.S3RedundancyReduced
+			}
+
+			switch d.Get("server_side_encryption").(string) {
+			case string(gofastly.S3ServerSideEncryptionAES):
+				opts.ServerSideEncryption = gofastly.S3ServerSideEncryptionAES
+			case string(gofastly.S3ServerSideEncryptionKMS):
+				opts.ServerSideEncryption = gofastly.S3ServerSideEncryptionKMS
+			}
+
+			_, err := conn.CreateS3(&opts)
+			return err
+		},
+
+		update: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			opts := gofastly.UpdateS3Input{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           d.Get("name").(string),
+			}
+
+			// Only attempt to update attributes that have changed.
+			if d.HasChange("bucket_name") {
+				opts.BucketName = gofastly.String(d.Get("bucket_name").(string))
+			}
+			if d.HasChange("s3_access_key") {
+				opts.AccessKey = gofastly.String(d.Get("s3_access_key").(string))
+			}
+			if d.HasChange("s3_secret_key") {
+				opts.SecretKey = gofastly.String(d.Get("s3_secret_key").(string))
+			}
+			if d.HasChange("path") {
+				opts.Path = gofastly.String(d.Get("path").(string))
+			}
+			if d.HasChange("domain") {
+				opts.Domain = gofastly.String(d.Get("domain").(string))
+			}
+			if d.HasChange("gzip_level") {
+				opts.GzipLevel = gofastly.Uint(uint(d.Get("gzip_level").(int)))
+			}
+			if d.HasChange("period") {
+				opts.Period = gofastly.Uint(uint(d.Get("period").(int)))
+			}
+			if d.HasChange("timestamp_format") {
+				opts.TimestampFormat = gofastly.String(d.Get("timestamp_format").(string))
+			}
+			if d.HasChange("redundancy") {
+				opts.Redundancy = gofastly.S3Redundancy(d.Get("redundancy").(string))
+			}
+			if d.HasChange("public_key") {
+				opts.PublicKey = gofastly.String(d.Get("public_key").(string))
+			}
+			if d.HasChange("message_type") {
+				opts.MessageType = gofastly.String(d.Get("message_type").(string))
+			}
+			if d.HasChange("server_side_encryption") {
+				opts.ServerSideEncryption = gofastly.S3ServerSideEncryption(d.Get("server_side_encryption").(string))
+			}
+			if d.HasChange("server_side_encryption_kms_key_id") {
+				opts.ServerSideEncryptionKMSKeyID = gofastly.String(d.Get("server_side_encryption_kms_key_id").(string))
+			}
+			if d.HasChange("format") {
+				opts.Format = gofastly.String(d.Get("format").(string))
+			}
+			if d.HasChange("format_version") {
+				opts.FormatVersion = gofastly.Uint(uint(d.Get("format_version").(int)))
+			}
+			if d.HasChange("response_condition") {
+				opts.ResponseCondition = gofastly.String(d.Get("response_condition").(string))
+			}
+			if d.HasChange("placement") {
+				opts.Placement = gofastly.String(d.Get("placement").(string))
+			}
+
+			_, err := conn.UpdateS3(&opts)
+			return err
+		},
+
+		delete: func(conn *gofastly.Client, serviceID string, version int, name string) error {
+			return conn.DeleteS3(&gofastly.DeleteS3Input{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+		},
+
+		read: func(conn *gofastly.Client, serviceID string, version int, name string, d *schema.ResourceData) error {
+			s, err := conn.GetS3(&gofastly.GetS3Input{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+			if err != nil {
+				return err
+			}
+
+			d.Set("bucket_name", s.BucketName)
+			d.Set("s3_access_key", s.AccessKey)
+			d.Set("s3_secret_key", s.SecretKey)
+			d.Set("path", s.Path)
+			d.Set("domain", s.Domain)
+			d.Set("gzip_level", int(s.GzipLevel))
+			d.Set("period", int(s.Period))
+			d.Set("timestamp_format", s.TimestampFormat)
+			d.Set("redundancy", string(s.Redundancy))
+			d.Set("public_key", s.PublicKey)
+			d.Set("message_type", s.MessageType)
+			d.Set("server_side_encryption", string(s.ServerSideEncryption))
+			d.Set("server_side_encryption_kms_key_id", s.ServerSideEncryptionKMSKeyID)
+			d.Set("format", s.Format)
+			d.Set("format_version", int(s.FormatVersion))
+			d.Set("response_condition", s.ResponseCondition)
+			d.Set("placement", s.Placement)
+			return nil
+		},
+	})
+}
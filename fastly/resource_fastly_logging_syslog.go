@@ -0,0 +1,212 @@
+package fastly
+
+import (
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceLoggingSyslog manages a single Syslog logging endpoint on a service
+// as a standalone resource. See resourceStandaloneLogging for the shared
+// lifecycle; the attributes mirror the `syslog` block of the service
+// resource.
+func resourceLoggingSyslog() *schema.Resource {
+	return resourceStandaloneLogging(standaloneLoggingEndpoint{
+		key: "syslog",
+		attributes: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A hostname or IPv4 address of the Syslog endpoint",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     514,
+				Description: "The port associated with the address where the Syslog endpoint can be accessed. Default `514`",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Whether to prepend each message with a specific token",
+				Sensitive:   true,
+			},
+			"use_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to use TLS for secure logging. Default `false`",
+			},
+			"tls_hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used during the TLS handshake to validate the certificate",
+			},
+			"tls_ca_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CA_CERT", ""),
+				Description:  "A secure certificate to authenticate the server with. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CA_CERT`",
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"tls_client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_CERT", ""),
+				Description:  "The client certificate used to make authenticated requests. Must be in PEM format. You can provide this certificate via an environment variable, `FASTLY_SYSLOG_CLIENT_CERT`",
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"tls_client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("FASTLY_SYSLOG_CLIENT_KEY", ""),
+				Description:  "The client private key used to make authenticated requests. Must be in PEM format. You can provide this key via an environment variable, `FASTLY_SYSLOG_CLIENT_KEY`",
+				Sensitive:    true,
+				ValidateFunc: validatePEMPrivateKey(),
+			},
+			"message_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "classic",
+				Description:  "How the message should be formatted; one of: `classic`, `loggly`, `logplex` or `blank`. Default `classic`",
+				ValidateFunc: validateLoggingMessageType(),
+			},
+			"format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "%h %l %u %t \"%r\" %>s %b",
+				Description:      "Apache-style string or VCL variables to use for log formatting",
+				DiffSuppressFunc: diffSuppressTrailingWhitespace,
+				ValidateFunc:     validateLogFormat(),
+			},
+			"format_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				Description:  "The version of the custom logging format. Can be either 1 or 2. (Default: 2)",
+				ValidateFunc: validateLoggingFormatVersion(),
+			},
+			"response_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of a condition to apply this logging.",
+			},
+			"placement": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Where in the generated VCL the logging call should be placed.",
+				ValidateFunc: validateLoggingPlacement(),
+			},
+		},
+
+		create: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			_, err := conn.CreateSyslog(&gofastly.CreateSyslogInput{
+				ServiceID:         serviceID,
+				ServiceVersion:    version,
+				Name:              d.Get("name").(string),
+				Address:           d.Get("address").(string),
+				Port:              uint(d.Get("port").(int)),
+				Token:             d.Get("token").(string),
+				UseTLS:            gofastly.Compatibool(d.Get("use_tls").(bool)),
+				TLSHostname:       d.Get("tls_hostname").(string),
+				TLSCACert:         d.Get("tls_ca_cert").(string),
+				TLSClientCert:     d.Get("tls_client_cert").(string),
+				TLSClientKey:      d.Get("tls_client_key").(string),
+				MessageType:       d.Get("message_type").(string),
+				Format:            d.Get("format").(string),
+				FormatVersion:     uint(d.Get("format_version").(int)),
+				ResponseCondition: d.Get("response_condition").(string),
+				Placement:         d.Get("placement").(string),
+			})
+			return err
+		},
+
+		update: func(conn *gofastly.Client, serviceID string, version int, d *schema.ResourceData) error {
+			opts := gofastly.UpdateSyslogInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           d.Get("name").(string),
+			}
+
+			// Only attempt to update attributes that have changed.
+			if d.HasChange("address") {
+				opts.Address = gofastly.String(d.Get("address").(string))
+			}
+			if d.HasChange("port") {
+				opts.Port = gofastly.Uint(uint(d.Get("port").(int)))
+			}
+			if d.HasChange("token") {
+				opts.Token = gofastly.String(d.Get("token").(string))
+			}
+			if d.HasChange("use_tls") {
+				opts.UseTLS = gofastly.CBool(d.Get("use_tls").(bool))
+			}
+			if d.HasChange("tls_hostname") {
+				opts.TLSHostname = gofastly.String(d.Get("tls_hostname").(string))
+			}
+			if d.HasChange("tls_ca_cert") {
+				opts.TLSCACert = gofastly.String(d.Get("tls_ca_cert").(string))
+			}
+			if d.HasChange("tls_client_cert") {
+				opts.TLSClientCert = gofastly.String(d.Get("tls_client_cert").(string))
+			}
+			if d.HasChange("tls_client_key") {
+				opts.TLSClientKey = gofastly.String(d.Get("tls_client_key").(string))
+			}
+			if d.HasChange("message_type") {
+				opts.MessageType = gofastly.String(d.Get("message_type").(string))
+			}
+			if d.HasChange("format") {
+				opts.Format = gofastly.String(d.Get("format").(string))
+			}
+			if d.HasChange("format_version") {
+				opts.FormatVersion = gofastly.Uint(uint(d.Get("format_version").(int)))
+			}
+			if d.HasChange("response_condition") {
+				opts.ResponseCondition = gofastly.String(d.Get("response_condition").(string))
+			}
+			if d.HasChange("placement") {
+				opts.Placement = gofastly.String(d.Get("placement").(string))
+			}
+
+			_, err := conn.UpdateSyslog(&opts)
+			return err
+		},
+
+		delete: func(conn *gofastly.Client, serviceID string, version int, name string) error {
+			return conn.DeleteSyslog(&gofastly.DeleteSyslogInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+		},
+
+		read: func(conn *gofastly.Client, serviceID string, version int, name string, d *schema.ResourceData) error {
+			s, err := conn.GetSyslog(&gofastly.GetSyslogInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			})
+			if err != nil {
+				return err
+			}
+
+			d.Set("address", s.Address)
+			d.Set("port", int(s.Port))
+			d.Set("token", s.Token)
+			d.Set("use_tls", s.UseTLS)
+			d.Set("tls_hostname", s.TLSHostname)
+			d.Set("tls_ca_cert", s.TLSCACert)
+			d.Set("tls_client_cert", s.TLSClientCert)
+			d.Set("tls_client_key", s.TLSClientKey)
+			d.Set("message_type", s.MessageType)
+			d.Set("format", s.Format)
+			d.Set("format_version", int(s.FormatVersion))
+			d.Set("response_condition", s.ResponseCondition)
+			d.Set("placement", s.Placement)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,252 @@
+package fastly
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestStandaloneLoggingImport(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		id            string
+		wantServiceID string
+		wantName      string
+		wantError     bool
+	}{
+		"valid id":              {id: "svc123/my-endpoint", wantServiceID: "svc123", wantName: "my-endpoint"},
+		"name containing slash": {id: "svc123/logs/primary", wantServiceID: "svc123", wantName: "logs/primary"},
+		"missing separator":     {id: "svc123", wantError: true},
+		"empty service id":      {id: "/my-endpoint", wantError: true},
+		"empty endpoint name":   {id: "svc123/", wantError: true},
+		"empty id":              {id: "", wantError: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceLoggingSyslog().Schema, map[string]interface{}{})
+			d.SetId(testCase.id)
+
+			results, err := standaloneLoggingImport(d, nil)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				if !strings.Contains(err.Error(), "Invalid id") {
+					t.Errorf("expected an invalid id error, got %q", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %q", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("expected one resource, got %d", len(results))
+			}
+			if got := results[0].Get("service_id").(string); got != testCase.wantServiceID {
+				t.Errorf("service_id mismatch, expected: %s, got: %s", testCase.wantServiceID, got)
+			}
+			if got := results[0].Get("name").(string); got != testCase.wantName {
+				t.Errorf("name mismatch, expected: %s, got: %s", testCase.wantName, got)
+			}
+			if !results[0].Get("activate").(bool) {
+				t.Error("expected activate to default to true on import")
+			}
+		})
+	}
+}
+
+func TestAccFastlyLoggingSyslog_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	endpointName := fmt.Sprintf("tf-syslog-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingSyslogConfig(serviceName, domainName, endpointName, "example.com", 514),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyLoggingSyslogRemoteState("fastly_service_v1.foo", endpointName, "example.com", 514),
+					resource.TestCheckResourceAttr("fastly_logging_syslog.logs", "address", "example.com"),
+					resource.TestCheckResourceAttr("fastly_logging_syslog.logs", "port", "514"),
+				),
+			},
+			{
+				Config: testAccLoggingSyslogConfig(serviceName, domainName, endpointName, "example.com", 6514),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyLoggingSyslogRemoteState("fastly_service_v1.foo", endpointName, "example.com", 6514),
+					resource.TestCheckResourceAttr("fastly_logging_syslog.logs", "port", "6514"),
+				),
+			},
+			{
+				ResourceName:            "fastly_logging_syslog.logs",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate"},
+			},
+		},
+	})
+}
+
+func TestAccFastlyLoggingKafka_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	endpointName := fmt.Sprintf("tf-kafka-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingKafkaConfig(serviceName, domainName, endpointName, "topic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_logging_kafka.logs", "topic", "topic"),
+					resource.TestCheckResourceAttr("fastly_logging_kafka.logs", "brokers", "127.0.0.1,127.0.0.2"),
+				),
+			},
+			{
+				Config: testAccLoggingKafkaConfig(serviceName, domainName, endpointName, "newtopic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_logging_kafka.logs", "topic", "newtopic"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastlyLoggingS3_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	endpointName := fmt.Sprintf("tf-s3-%s", acctest.RandString(10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%s", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingS3Config(serviceName, domainName, endpointName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_logging_s3.logs", "bucket_name", bucketName),
+					resource.TestCheckResourceAttr("fastly_logging_s3.logs", "name", endpointName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyLoggingSyslogRemoteState(serviceResource, endpointName, address string, port int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serviceResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceResource)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		version, err := readServiceVersion(conn, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+
+		syslog, err := conn.GetSyslog(&gofastly.GetSyslogInput{
+			ServiceID:      rs.Primary.ID,
+			ServiceVersion: version,
+			Name:           endpointName,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Syslog endpoint for (%s), version (%v): %s", rs.Primary.ID, version, err)
+		}
+
+		if syslog.Address != address {
+			return fmt.Errorf("Syslog address mismatch, expected: %s, got: %s", address, syslog.Address)
+		}
+		if int(syslog.Port) != port {
+			return fmt.Errorf("Syslog port mismatch, expected: %d, got: %d", port, syslog.Port)
+		}
+
+		return nil
+	}
+}
+
+// In each config the service intentionally has no logging blocks: the
+// standalone resource owns the endpoint, and the service resource skips
+// refreshing block types it does not manage. Activation is left off so no
+// backend is required.
+func testAccLoggingSyslogConfig(serviceName, domain, endpointName, address string, port int) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_logging_syslog" "logs" {
+  service_id = fastly_service_v1.foo.id
+  name       = "%s"
+  address    = "%s"
+  port       = %d
+  activate   = false
+}`, serviceName, domain, endpointName, address, port)
+}
+
+func testAccLoggingKafkaConfig(serviceName, domain, endpointName, topic string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_logging_kafka" "logs" {
+  service_id = fastly_service_v1.foo.id
+  name       = "%s"
+  topic      = "%s"
+  brokers    = "127.0.0.1,127.0.0.2"
+  activate   = false
+}`, serviceName, domain, endpointName, topic)
+}
+
+func testAccLoggingS3Config(serviceName, domain, endpointName, bucketName string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_logging_s3" "logs" {
+  service_id    = fastly_service_v1.foo.id
+  name          = "%s"
+  bucket_name   = "%s"
+  s3_access_key = "%s"
+  s3_secret_key = "%s"
+  activate      = false
+}`, serviceName, domain, endpointName, bucketName, testAwsPrimaryAccessKey, testAwsPrimarySecretKey)
+}
@@ -2,6 +2,9 @@ package fastly
 
 import (
 	"fmt"
+	"log"
+	"net"
+	"strconv"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
@@ -17,6 +20,7 @@ func resourceServiceAclEntriesV1() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceServiceACLEntriesV1Import,
 		},
+		CustomizeDiff: validateACLEntrySemantics,
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -45,14 +49,16 @@ func resourceServiceAclEntriesV1() *schema.Resource {
 							Computed:    true,
 						},
 						"ip": {
-							Type:        schema.TypeString,
-							Description: "An IP address that is the focus for the ACL",
-							Required:    true,
+							Type:         schema.TypeString,
+							Description:  "An IP address that is the focus for the ACL",
+							Required:     true,
+							ValidateFunc: validateACLEntryIP(),
 						},
 						"subnet": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "An optional subnet mask applied to the IP address",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "An optional subnet mask applied to the IP address",
+							ValidateFunc: validateACLEntrySubnet(),
 						},
 						"negated": {
 							Type:        schema.TypeBool,
@@ -61,9 +67,10 @@ func resourceServiceAclEntriesV1() *schema.Resource {
 							Description: "A boolean that will negate the match if true",
 						},
 						"comment": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "A personal freeform descriptive note",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "A personal freeform descriptive note",
+							ValidateFunc: validateACLEntryComment(),
 						},
 					},
 				},
@@ -72,10 +79,60 @@ func resourceServiceAclEntriesV1() *schema.Resource {
 	}
 }
 
+// validateACLEntrySemantics applies the cross-field entry checks that the
+// per-attribute validators cannot: the subnet prefix length must fit the
+// address family of `ip`, and the same network must not be listed both negated
+// and not, which the API accepts but which makes the match order-dependent.
+func validateACLEntrySemantics(diff *schema.ResourceDiff, v interface{}) error {
+	negatedBy := make(map[string]bool)
+
+	entries := diff.Get("entry").(*schema.Set).List()
+	for _, e := range entries {
+		entry := e.(map[string]interface{})
+		ip := entry["ip"].(string)
+		subnet := entry["subnet"].(string)
+
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			// The per-attribute validator already rejected it.
+			continue
+		}
+
+		if subnet != "" {
+			prefix, err := strconv.Atoi(subnet)
+			if err != nil {
+				continue
+			}
+			if parsed.To4() != nil && prefix > 32 {
+				return fmt.Errorf("entry %s/%s: a subnet prefix length over 32 is not valid for an IPv4 address", ip, subnet)
+			}
+		}
+
+		network := ip
+		if subnet != "" {
+			network = ip + "/" + subnet
+		}
+		negated := entry["negated"].(bool)
+		if seen, ok := negatedBy[network]; ok && seen != negated {
+			return fmt.Errorf("entry %s is listed both negated and not; remove one, as the ACL cannot both match and exclude the same network", network)
+		}
+		negatedBy[network] = negated
+	}
+
+	return nil
+}
+
 func resourceServiceAclEntriesV1Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	aclID := d.Get("acl_id").(string)
 	entries := d.Get("entry").(*schema.Set)
 
@@ -109,10 +166,7 @@ func resourceServiceAclEntriesV1Read(d *schema.ResourceData, meta interface{}) e
 	serviceID := d.Get("service_id").(string)
 	aclID := d.Get("acl_id").(string)
 
-	aclEntries, err := conn.ListACLEntries(&gofastly.ListACLEntriesInput{
-		ServiceID: serviceID,
-		ACLID:     aclID,
-	})
+	aclEntries, err := listACLEntriesPaginated(conn, serviceID, aclID)
 
 	if err != nil {
 		return err
@@ -124,9 +178,16 @@ func resourceServiceAclEntriesV1Read(d *schema.ResourceData, meta interface{}) e
 
 func resourceServiceAclEntriesV1Update(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	aclID := d.Get("acl_id").(string)
 
 	var batchACLEntries = []*gofastly.BatchACLEntry{}
@@ -206,9 +267,16 @@ func resourceServiceAclEntriesV1Update(d *schema.ResourceData, meta interface{})
 }
 
 func resourceServiceAclEntriesV1Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	aclID := d.Get("acl_id").(string)
 	entries := d.Get("entry").(*schema.Set)
 
@@ -261,7 +329,7 @@ func flattenAclEntries(aclEntryList []*gofastly.ACLEntry) []map[string]interface
 func resourceServiceACLEntriesV1Import(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	split := strings.Split(d.Id(), "/")
 
-	if len(split) != 2 {
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
 		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[acl_id]", d.Id())
 	}
 
@@ -281,7 +349,13 @@ func resourceServiceACLEntriesV1Import(d *schema.ResourceData, m interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
+// executeBatchACLOperations applies a set of entry changes through the batch
+// endpoint, chunked to the API's maximum batch size, rather than issuing one
+// request per entry.
 func executeBatchACLOperations(conn *gofastly.Client, serviceID, aclID string, batchACLEntries []*gofastly.BatchACLEntry) error {
+	if len(batchACLEntries) == 0 {
+		return nil
+	}
 
 	batchSize := gofastly.BatchModifyMaximumOperations
 
@@ -291,6 +365,7 @@ func executeBatchACLOperations(conn *gofastly.Client, serviceID, aclID string, b
 			j = len(batchACLEntries)
 		}
 
+		log.Printf("[DEBUG] Batch modifying ACL (%s) entries %d-%d of %d", aclID, i, j, len(batchACLEntries))
 		err := conn.BatchModifyACLEntries(&gofastly.BatchModifyACLEntriesInput{
 			ServiceID: serviceID,
 			ACLID:     aclID,
@@ -0,0 +1,578 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceServiceBackend manages a single backend on a service as a standalone
+// resource, keyed by service ID and backend name. It exists so automation that
+// only flips origin addresses can apply a change without owning (or diffing)
+// the whole service resource. Each apply clones the active version, modifies
+// the backend and, unless `activate` is false, activates the result.
+//
+// A backend must not be managed both here and in a `backend` block of the
+// service resource, or the two will fight over it.
+func resourceServiceBackend() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceBackendCreate,
+		Read:   resourceServiceBackendRead,
+		Update: resourceServiceBackendUpdate,
+		Delete: resourceServiceBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceBackendImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Service that the Backend belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name for this Backend. Must be unique to this Service. It is important to note that changing this attribute will delete and recreate the resource",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An IPv4, hostname, or IPv6 address for the Backend",
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to activate the new service version after a change to this Backend. Default `true`",
+			},
+			"auto_loadbalance": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Denotes if this Backend should be included in the pool of backends that requests are load balanced against. Default `true`",
+			},
+			"between_bytes_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				Description:  "How long to wait between bytes in milliseconds. Default `10000`",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"connect_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				Description:  "How long to wait for a timeout in milliseconds. Default `1000`",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"error_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Number of errors to allow before the Backend is marked as down. Default `0`",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"first_byte_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      15000,
+				Description:  "How long to wait for the first bytes in milliseconds. Default `15000`",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"healthcheck": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of a defined `healthcheck` to assign to this backend",
+			},
+			"max_conn": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				Description:  "Maximum number of connections for this Backend. Default `200`",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     80,
+				Description: "The port number on which the Backend responds. Default `80`",
+			},
+			"override_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The hostname to override the Host header",
+			},
+			"request_condition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of a condition, which if met, will select this backend during a request.",
+			},
+			"shield": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The POP of the shield designated to reduce inbound load. Valid values for `shield` are included in the `GET /datacenters` API response",
+			},
+			"use_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether or not to use SSL to reach the Backend. Default `false`",
+			},
+			"max_tls_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Maximum allowed TLS version on SSL connections to this backend.",
+			},
+			"min_tls_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Minimum allowed TLS version on SSL connections to this backend.",
+			},
+			"ssl_ciphers": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma separated list of OpenSSL Ciphers to try when negotiating to the backend",
+			},
+			"ssl_check_cert": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Be strict about checking SSL certs. Default `true`",
+			},
+			"ssl_ca_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  "CA certificate attached to origin.",
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"ssl_ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   "Path to a local file containing the CA certificate, read at apply time. Only a SHA-256 of the content is kept in state",
+				ConflictsWith: []string{"ssl_ca_cert"},
+			},
+			"ssl_ca_cert_sha256": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Used to trigger updates when `ssl_ca_cert_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"ca.pem\")`",
+			},
+			"ssl_cert_hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Hostname used for cert verification. Does not affect SNI at all",
+			},
+			"ssl_sni_hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Hostname used for SNI in the handshake. Does not affect cert validation at all",
+			},
+			"ssl_client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  "Client certificate attached to origin. Used when connecting to the backend",
+				Sensitive:    true,
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"ssl_client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   "Path to a local file containing the client certificate, read at apply time. Only a SHA-256 of the content is kept in state",
+				ConflictsWith: []string{"ssl_client_cert"},
+			},
+			"ssl_client_cert_sha256": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Used to trigger updates when `ssl_client_cert_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"client.pem\")`",
+			},
+			"ssl_client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				Description:  "Client key attached to origin. Used when connecting to the backend",
+				Sensitive:    true,
+				ValidateFunc: validatePEMPrivateKey(),
+			},
+			"ssl_client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				Description:   "Path to a local file containing the client key, read at apply time, for policies that forbid PEM material in state files. Only a SHA-256 of the content is kept in state",
+				ConflictsWith: []string{"ssl_client_key"},
+			},
+			"ssl_client_key_sha256": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Used to trigger updates when `ssl_client_key_file` changes. Must be set to a SHA256 hash of the file; the usual way is `filesha256(\"client.key\")`",
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "The [portion of traffic](https://docs.fastly.com/en/guides/load-balancing-configuration#how-weight-affects-load-balancing) to send to this Backend. Each Backend receives weight / total of the traffic. Default `100`",
+			},
+		},
+	}
+}
+
+func resourceServiceBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	sslCACert, err := fileBackedValue("ssl_ca_cert", d.Get("ssl_ca_cert").(string), d.Get("ssl_ca_cert_file").(string))
+	if err != nil {
+		return err
+	}
+	sslClientCert, err := fileBackedValue("ssl_client_cert", d.Get("ssl_client_cert").(string), d.Get("ssl_client_cert_file").(string))
+	if err != nil {
+		return err
+	}
+	sslClientKey, err := fileBackedValue("ssl_client_key", d.Get("ssl_client_key").(string), d.Get("ssl_client_key_file").(string))
+	if err != nil {
+		return err
+	}
+
+	_, err = modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.CreateBackendInput{
+			ServiceID:           serviceID,
+			ServiceVersion:      version,
+			Name:                name,
+			Address:             d.Get("address").(string),
+			OverrideHost:        d.Get("override_host").(string),
+			AutoLoadbalance:     gofastly.Compatibool(d.Get("auto_loadbalance").(bool)),
+			SSLCheckCert:        gofastly.Compatibool(d.Get("ssl_check_cert").(bool)),
+			SSLCACert:           sslCACert,
+			SSLCertHostname:     d.Get("ssl_cert_hostname").(string),
+			SSLSNIHostname:      d.Get("ssl_sni_hostname").(string),
+			UseSSL:              gofastly.Compatibool(d.Get("use_ssl").(bool)),
+			SSLClientKey:        sslClientKey,
+			SSLClientCert:       sslClientCert,
+			MaxTLSVersion:       d.Get("max_tls_version").(string),
+			MinTLSVersion:       d.Get("min_tls_version").(string),
+			SSLCiphers:          strings.Split(d.Get("ssl_ciphers").(string), ","),
+			Shield:              d.Get("shield").(string),
+			Port:                uint(d.Get("port").(int)),
+			BetweenBytesTimeout: uint(d.Get("between_bytes_timeout").(int)),
+			ConnectTimeout:      uint(d.Get("connect_timeout").(int)),
+			ErrorThreshold:      uint(d.Get("error_threshold").(int)),
+			FirstByteTimeout:    uint(d.Get("first_byte_timeout").(int)),
+			MaxConn:             uint(d.Get("max_conn").(int)),
+			Weight:              uint(d.Get("weight").(int)),
+			HealthCheck:         d.Get("healthcheck").(string),
+			RequestCondition:    d.Get("request_condition").(string),
+		}
+
+		log.Printf("[DEBUG] Create Backend Opts: %#v", opts)
+		if _, err := conn.CreateBackend(&opts); err != nil {
+			return err
+		}
+
+		// Explicit zeroes are dropped from the create payload, letting the API
+		// apply its non-zero defaults; carry them over in a follow-up update.
+		zeroed := explicitZeroNumericFields(map[string]interface{}{
+			"between_bytes_timeout": d.Get("between_bytes_timeout").(int),
+			"connect_timeout":       d.Get("connect_timeout").(int),
+			"first_byte_timeout":    d.Get("first_byte_timeout").(int),
+		}, "between_bytes_timeout", "connect_timeout", "first_byte_timeout")
+		if len(zeroed) > 0 {
+			updateOpts := gofastly.UpdateBackendInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			}
+			if _, ok := zeroed["between_bytes_timeout"]; ok {
+				updateOpts.BetweenBytesTimeout = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["connect_timeout"]; ok {
+				updateOpts.ConnectTimeout = gofastly.Uint(0)
+			}
+			if _, ok := zeroed["first_byte_timeout"]; ok {
+				updateOpts.FirstByteTimeout = gofastly.Uint(0)
+			}
+
+			log.Printf("[DEBUG] Update Backend Opts (explicit zeroes): %#v", updateOpts)
+			if _, err := conn.UpdateBackend(&updateOpts); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating backend: service %s, backend %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, name))
+	return resourceServiceBackendRead(d, meta)
+}
+
+func resourceServiceBackendRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	name := d.Get("name").(string)
+
+	version, err := readServiceVersion(conn, serviceID, d.Get("activate").(bool))
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Fastly Service (%s): %s", serviceID, err)
+	}
+
+	log.Printf("[DEBUG] Refreshing Backend (%s) for (%s), version (%d)", name, serviceID, version)
+	b, err := conn.GetBackend(&gofastly.GetBackendInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			log.Printf("[WARN] Backend (%s) not found for service (%s), removing from state", name, serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("address", b.Address)
+	d.Set("auto_loadbalance", b.AutoLoadbalance)
+	d.Set("between_bytes_timeout", int(b.BetweenBytesTimeout))
+	d.Set("connect_timeout", int(b.ConnectTimeout))
+	d.Set("error_threshold", int(b.ErrorThreshold))
+	d.Set("first_byte_timeout", int(b.FirstByteTimeout))
+	d.Set("max_conn", int(b.MaxConn))
+	d.Set("port", int(b.Port))
+	d.Set("override_host", b.OverrideHost)
+	d.Set("request_condition", b.RequestCondition)
+	d.Set("shield", b.Shield)
+	d.Set("ssl_check_cert", b.SSLCheckCert)
+
+	// For file-backed PEM attributes only a hash of the content is kept in
+	// state; the hash attribute is left untouched unless the user opted in by
+	// setting it, so an unset hash does not produce a perpetual diff.
+	setBackendSecret := func(key, apiValue string) {
+		if d.Get(key+"_file").(string) == "" {
+			d.Set(key, apiValue)
+			return
+		}
+		d.Set(key, "")
+		if d.Get(key+"_sha256").(string) != "" {
+			d.Set(key+"_sha256", secretSHA256(apiValue))
+		}
+	}
+	setBackendSecret("ssl_ca_cert", b.SSLCACert)
+	setBackendSecret("ssl_client_key", b.SSLClientKey)
+	setBackendSecret("ssl_client_cert", b.SSLClientCert)
+	d.Set("max_tls_version", b.MaxTLSVersion)
+	d.Set("min_tls_version", b.MinTLSVersion)
+	d.Set("ssl_ciphers", strings.Join(b.SSLCiphers, ","))
+	d.Set("use_ssl", b.UseSSL)
+	d.Set("ssl_cert_hostname", b.SSLCertHostname)
+	d.Set("ssl_sni_hostname", b.SSLSNIHostname)
+	d.Set("weight", int(b.Weight))
+	d.Set("healthcheck", b.HealthCheck)
+
+	return nil
+}
+
+func resourceServiceBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.UpdateBackendInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		}
+
+		// Only attempt to update attributes that have changed.
+		if d.HasChange("address") {
+			opts.Address = gofastly.String(d.Get("address").(string))
+		}
+		if d.HasChange("port") {
+			opts.Port = gofastly.Uint(uint(d.Get("port").(int)))
+		}
+		if d.HasChange("override_host") {
+			opts.OverrideHost = gofastly.String(d.Get("override_host").(string))
+		}
+		if d.HasChange("connect_timeout") {
+			opts.ConnectTimeout = gofastly.Uint(uint(d.Get("connect_timeout").(int)))
+		}
+		if d.HasChange("max_conn") {
+			opts.MaxConn = gofastly.Uint(uint(d.Get("max_conn").(int)))
+		}
+		if d.HasChange("error_threshold") {
+			opts.ErrorThreshold = gofastly.Uint(uint(d.Get("error_threshold").(int)))
+		}
+		if d.HasChange("first_byte_timeout") {
+			opts.FirstByteTimeout = gofastly.Uint(uint(d.Get("first_byte_timeout").(int)))
+		}
+		if d.HasChange("between_bytes_timeout") {
+			opts.BetweenBytesTimeout = gofastly.Uint(uint(d.Get("between_bytes_timeout").(int)))
+		}
+		if d.HasChange("auto_loadbalance") {
+			opts.AutoLoadbalance = gofastly.CBool(d.Get("auto_loadbalance").(bool))
+		}
+		if d.HasChange("weight") {
+			opts.Weight = gofastly.Uint(uint(d.Get("weight").(int)))
+		}
+		if d.HasChange("request_condition") {
+			opts.RequestCondition = gofastly.String(d.Get("request_condition").(string))
+		}
+		if d.HasChange("healthcheck") {
+			opts.HealthCheck = gofastly.String(d.Get("healthcheck").(string))
+		}
+		if d.HasChange("shield") {
+			opts.Shield = gofastly.String(d.Get("shield").(string))
+		}
+		if d.HasChange("use_ssl") {
+			opts.UseSSL = gofastly.CBool(d.Get("use_ssl").(bool))
+		}
+		if d.HasChange("ssl_check_cert") {
+			opts.SSLCheckCert = gofastly.CBool(d.Get("ssl_check_cert").(bool))
+		}
+		if d.HasChange("ssl_ca_cert") || d.HasChange("ssl_ca_cert_file") || d.HasChange("ssl_ca_cert_sha256") {
+			v, err := fileBackedValue("ssl_ca_cert", d.Get("ssl_ca_cert").(string), d.Get("ssl_ca_cert_file").(string))
+			if err != nil {
+				return err
+			}
+			opts.SSLCACert = gofastly.String(v)
+		}
+		if d.HasChange("ssl_client_cert") || d.HasChange("ssl_client_cert_file") || d.HasChange("ssl_client_cert_sha256") {
+			v, err := fileBackedValue("ssl_client_cert", d.Get("ssl_client_cert").(string), d.Get("ssl_client_cert_file").(string))
+			if err != nil {
+				return err
+			}
+			opts.SSLClientCert = gofastly.String(v)
+		}
+		if d.HasChange("ssl_client_key") || d.HasChange("ssl_client_key_file") || d.HasChange("ssl_client_key_sha256") {
+			v, err := fileBackedValue("ssl_client_key", d.Get("ssl_client_key").(string), d.Get("ssl_client_key_file").(string))
+			if err != nil {
+				return err
+			}
+			opts.SSLClientKey = gofastly.String(v)
+		}
+		if d.HasChange("ssl_cert_hostname") {
+			opts.SSLCertHostname = gofastly.String(d.Get("ssl_cert_hostname").(string))
+		}
+		if d.HasChange("ssl_sni_hostname") {
+			opts.SSLSNIHostname = gofastly.String(d.Get("ssl_sni_hostname").(string))
+		}
+		if d.HasChange("min_tls_version") {
+			opts.MinTLSVersion = gofastly.String(d.Get("min_tls_version").(string))
+		}
+		if d.HasChange("max_tls_version") {
+			opts.MaxTLSVersion = gofastly.String(d.Get("max_tls_version").(string))
+		}
+		if d.HasChange("ssl_ciphers") {
+			opts.SSLCiphers = strings.Split(d.Get("ssl_ciphers").(string), ",")
+		}
+
+		log.Printf("[DEBUG] Update Backend Opts: %#v", opts)
+		_, err := conn.UpdateBackend(&opts)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating backend: service %s, backend %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	return resourceServiceBackendRead(d, meta)
+}
+
+func resourceServiceBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.DeleteBackendInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		}
+
+		log.Printf("[DEBUG] Fastly Backend removal opts: %#v", opts)
+		err := conn.DeleteBackend(&opts)
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting backend: service %s, backend %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceBackendImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.Split(d.Id(), "/")
+
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[backend_name]", d.Id())
+	}
+
+	if err := d.Set("service_id", split[0]); err != nil {
+		return nil, fmt.Errorf("Error importing backend: service %s, backend %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("name", split[1]); err != nil {
+		return nil, fmt.Errorf("Error importing backend: service %s, backend %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("activate", true); err != nil {
+		return nil, fmt.Errorf("Error importing backend: service %s, backend %s, %s", split[0], split[1], err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,107 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccFastlyServiceBackend_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	backendName := "origin"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceBackendConfig(serviceName, domainName, backendName, "aws.amazon.com", 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceBackendRemoteState("fastly_service_v1.foo", backendName, "aws.amazon.com", 80),
+					resource.TestCheckResourceAttr("fastly_service_backend.origin", "address", "aws.amazon.com"),
+					resource.TestCheckResourceAttr("fastly_service_backend.origin", "port", "80"),
+				),
+			},
+			{
+				Config: testAccServiceBackendConfig(serviceName, domainName, backendName, "docs.aws.amazon.com", 443),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceBackendRemoteState("fastly_service_v1.foo", backendName, "docs.aws.amazon.com", 443),
+					resource.TestCheckResourceAttr("fastly_service_backend.origin", "address", "docs.aws.amazon.com"),
+					resource.TestCheckResourceAttr("fastly_service_backend.origin", "port", "443"),
+				),
+			},
+			{
+				ResourceName:            "fastly_service_backend.origin",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate"},
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceBackendRemoteState(serviceResource, backendName, address string, port int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serviceResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceResource)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		version, err := readServiceVersion(conn, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+
+		backend, err := conn.GetBackend(&gofastly.GetBackendInput{
+			ServiceID:      rs.Primary.ID,
+			ServiceVersion: version,
+			Name:           backendName,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Backend for (%s), version (%v): %s", rs.Primary.ID, version, err)
+		}
+
+		if backend.Address != address {
+			return fmt.Errorf("Backend address mismatch, expected: %s, got: %s", address, backend.Address)
+		}
+		if int(backend.Port) != port {
+			return fmt.Errorf("Backend port mismatch, expected: %d, got: %d", port, backend.Port)
+		}
+
+		return nil
+	}
+}
+
+// The service intentionally has no backend blocks: the standalone resource
+// owns the backend, and the service resource skips refreshing block types it
+// does not manage, so the two do not fight over it. Activation is left off so
+// a backendless service config is valid.
+func testAccServiceBackendConfig(serviceName, domain, backendName, address string, port int) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_service_backend" "origin" {
+  service_id = fastly_service_v1.foo.id
+  name       = "%s"
+  address    = "%s"
+  port       = %d
+  activate   = false
+}`, serviceName, domain, backendName, address, port)
+}
@@ -0,0 +1,13 @@
+package fastly
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceServiceBotManagement enables the Fastly Bot Management product on a
+// service. Verification and challenge behaviour is configured in VCL (via
+// snippets or custom VCL) once the product is enabled; the products API only
+// exposes the on/off switch.
+func resourceServiceBotManagement() *schema.Resource {
+	return resourceProductEnablement("bot_management")
+}
@@ -0,0 +1,144 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceServiceDDoSProtection enables the Fastly DDoS Protection product on
+// a service and manages its operating mode. Enablement goes through the
+// shared products API helpers; the mode lives in the product's configuration
+// endpoint and is read back on refresh so posture drift (e.g. someone
+// flipping block to log in the console) shows up in a plan.
+func resourceServiceDDoSProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceDDoSProtectionCreate,
+		Read:   resourceServiceDDoSProtectionRead,
+		Update: resourceServiceDDoSProtectionUpdate,
+		Delete: resourceServiceDDoSProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("service_id", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Service to enable DDoS Protection on",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "log",
+				Description:  "Operating mode for DDoS Protection. In `log` mode attacks are only recorded; in `block` mode they are mitigated. Default `log`",
+				ValidateFunc: validation.StringInSlice([]string{"log", "block"}, false),
+			},
+		},
+	}
+}
+
+const ddosProtectionProductID = "ddos_protection"
+
+// ddosProtectionConfiguration is the payload of the product's configuration
+// endpoint.
+type ddosProtectionConfiguration struct {
+	Mode string `json:"mode"`
+}
+
+func ddosProtectionConfigurationPath(serviceID string) string {
+	return productPath(ddosProtectionProductID, serviceID) + "/configuration"
+}
+
+func updateDDoSProtectionMode(conn *gofastly.Client, serviceID, mode string) error {
+	resp, err := conn.PatchJSON(ddosProtectionConfigurationPath(serviceID), &ddosProtectionConfiguration{Mode: mode}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func resourceServiceDDoSProtectionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Get("service_id").(string)
+
+	log.Printf("[DEBUG] Enabling DDoS Protection for service (%s)", serviceID)
+	if err := enableProduct(conn, ddosProtectionProductID, serviceID); err != nil {
+		return fmt.Errorf("Error enabling DDoS Protection for service %s: %s", serviceID, err)
+	}
+
+	if err := updateDDoSProtectionMode(conn, serviceID, d.Get("mode").(string)); err != nil {
+		return fmt.Errorf("Error setting DDoS Protection mode for service %s: %s", serviceID, err)
+	}
+
+	d.SetId(serviceID)
+	return resourceServiceDDoSProtectionRead(d, meta)
+}
+
+func resourceServiceDDoSProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Get("service_id").(string)
+
+	enabled, err := productEnabled(conn, ddosProtectionProductID, serviceID)
+	if err != nil {
+		return fmt.Errorf("Error checking DDoS Protection for service %s: %s", serviceID, err)
+	}
+	if !enabled {
+		log.Printf("[WARN] DDoS Protection no longer enabled for service (%s), removing from state", serviceID)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := conn.Get(ddosProtectionConfigurationPath(serviceID), nil)
+	if err != nil {
+		return fmt.Errorf("Error reading DDoS Protection configuration for service %s: %s", serviceID, err)
+	}
+	defer resp.Body.Close()
+
+	var config ddosProtectionConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return fmt.Errorf("Error decoding DDoS Protection configuration for service %s: %s", serviceID, err)
+	}
+
+	d.Set("mode", config.Mode)
+	return nil
+}
+
+func resourceServiceDDoSProtectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Get("service_id").(string)
+
+	if d.HasChange("mode") {
+		log.Printf("[DEBUG] Updating DDoS Protection mode for service (%s)", serviceID)
+		if err := updateDDoSProtectionMode(conn, serviceID, d.Get("mode").(string)); err != nil {
+			return fmt.Errorf("Error setting DDoS Protection mode for service %s: %s", serviceID, err)
+		}
+	}
+
+	return resourceServiceDDoSProtectionRead(d, meta)
+}
+
+func resourceServiceDDoSProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+	serviceID := d.Get("service_id").(string)
+
+	log.Printf("[DEBUG] Disabling DDoS Protection for service (%s)", serviceID)
+	if err := disableProduct(conn, ddosProtectionProductID, serviceID); err != nil {
+		if httpErr, ok := err.(*gofastly.HTTPError); ok && httpErr.IsNotFound() {
+			return nil
+		}
+		return fmt.Errorf("Error disabling DDoS Protection for service %s: %s", serviceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDDoSProtectionConfigurationPath(t *testing.T) {
+	got := ddosProtectionConfigurationPath("svc123")
+	want := "/enabled-products/v1/ddos_protection/services/svc123/configuration"
+	if got != want {
+		t.Errorf("configuration path mismatch, expected: %s, got: %s", want, got)
+	}
+}
+
+// DDoS Protection requires the product to be available on the account, so
+// this is only exercised on suitably entitled accounts.
+func TestAccFastlyServiceDDoSProtection_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDDoSProtectionConfig(serviceName, domainName, "log"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_ddos_protection.protection", "mode", "log"),
+					resource.TestCheckResourceAttrPair("fastly_service_ddos_protection.protection", "service_id", "fastly_service_v1.foo", "id"),
+				),
+			},
+			{
+				Config: testAccServiceDDoSProtectionConfig(serviceName, domainName, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("fastly_service_ddos_protection.protection", "mode", "block"),
+				),
+			},
+			{
+				ResourceName:      "fastly_service_ddos_protection.protection",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServiceDDoSProtectionConfig(serviceName, domain, mode string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_service_ddos_protection" "protection" {
+  service_id = fastly_service_v1.foo.id
+  mode       = "%s"
+}`, serviceName, domain, mode)
+}
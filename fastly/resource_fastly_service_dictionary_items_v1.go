@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"log"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
@@ -44,9 +45,16 @@ func resourceServiceDictionaryItemsV1() *schema.Resource {
 }
 
 func resourceServiceDictionaryItemsV1Create(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	dictionaryID := d.Get("dictionary_id").(string)
 	items := d.Get("items").(map[string]interface{})
 
@@ -73,9 +81,16 @@ func resourceServiceDictionaryItemsV1Create(d *schema.ResourceData, meta interfa
 
 func resourceServiceDictionaryItemsV1Update(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	dictionaryID := d.Get("dictionary_id").(string)
 
 	d.Partial(true)
@@ -143,10 +158,7 @@ func resourceServiceDictionaryItemsV1Read(d *schema.ResourceData, meta interface
 	serviceID := d.Get("service_id").(string)
 	dictionaryID := d.Get("dictionary_id").(string)
 
-	dictList, err := conn.ListDictionaryItems(&gofastly.ListDictionaryItemsInput{
-		ServiceID:    serviceID,
-		DictionaryID: dictionaryID,
-	})
+	dictList, err := listDictionaryItemsPaginated(conn, serviceID, dictionaryID)
 	if err != nil {
 		return err
 	}
@@ -156,9 +168,16 @@ func resourceServiceDictionaryItemsV1Read(d *schema.ResourceData, meta interface
 }
 
 func resourceServiceDictionaryItemsV1Delete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	dictionaryID := d.Get("dictionary_id").(string)
 	items := d.Get("items").(map[string]interface{})
 
@@ -184,7 +203,7 @@ func resourceServiceDictionaryItemsV1Delete(d *schema.ResourceData, meta interfa
 func resourceServiceDictionaryItemsV1Import(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	split := strings.Split(d.Id(), "/")
 
-	if len(split) != 2 {
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
 		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[dictionary_id]", d.Id())
 	}
 
@@ -213,7 +232,13 @@ func flattenDictionaryItems(dictItemList []*gofastly.DictionaryItem) map[string]
 	return resultList
 }
 
+// executeBatchDictionaryOperations applies a set of item changes through the
+// batch endpoint, chunked to the API's maximum batch size, rather than
+// issuing one request per item.
 func executeBatchDictionaryOperations(conn *gofastly.Client, serviceID, dictionaryID string, batchDictionaryItems []*gofastly.BatchDictionaryItem) error {
+	if len(batchDictionaryItems) == 0 {
+		return nil
+	}
 
 	batchSize := gofastly.BatchModifyMaximumOperations
 
@@ -223,6 +248,7 @@ func executeBatchDictionaryOperations(conn *gofastly.Client, serviceID, dictiona
 			j = len(batchDictionaryItems)
 		}
 
+		log.Printf("[DEBUG] Batch modifying dictionary (%s) items %d-%d of %d", dictionaryID, i, j, len(batchDictionaryItems))
 		err := conn.BatchModifyDictionaryItems(&gofastly.BatchModifyDictionaryItemsInput{
 			ServiceID:    serviceID,
 			DictionaryID: dictionaryID,
@@ -0,0 +1,238 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceServiceDomain manages a single domain on a service as a standalone
+// resource, keyed by service ID and domain name. Platforms onboarding many
+// customer domains can for_each over a list of them instead of maintaining one
+// large `domain` set on the service resource. Each apply clones the active
+// version, modifies the domain and, unless `activate` is false, activates the
+// result.
+//
+// A domain must not be managed both here and in a `domain` block of the
+// service resource, or the two will fight over it.
+func resourceServiceDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceDomainCreate,
+		Read:   resourceServiceDomainRead,
+		Update: resourceServiceDomainUpdate,
+		Delete: resourceServiceDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceDomainImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Service that the Domain belongs to",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The domain that this Service will respond to. It is important to note that changing this attribute will delete and recreate the resource.",
+				StateFunc:    domainNameStateFunc,
+				ValidateFunc: validateDomainName(),
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "An optional comment about the Domain.",
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to activate the new service version after a change to this Domain. Default `true`",
+			},
+			"allow_takeover": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Retry domain-conflict errors during create for up to two minutes, so a domain being moved here from another service managed in the same run can be released first. Default `false`",
+			},
+		},
+	}
+}
+
+func resourceServiceDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	// The whole clone/create/activate step is retried on domain conflicts
+	// when allow_takeover is set, so guard the create to keep it idempotent
+	// across attempts.
+	err := retryDomainConflict(d.Get("allow_takeover").(bool), domainTakeoverTimeout, fmt.Sprintf("Adding domain (%s)", name), func() error {
+		_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+			if _, err := conn.GetDomain(&gofastly.GetDomainInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+			}); err == nil {
+				return nil
+			}
+
+			opts := gofastly.CreateDomainInput{
+				ServiceID:      serviceID,
+				ServiceVersion: version,
+				Name:           name,
+				Comment:        d.Get("comment").(string),
+			}
+
+			log.Printf("[DEBUG] Fastly Domain Addition opts: %#v", opts)
+			_, err := conn.CreateDomain(&opts)
+			return err
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating domain: service %s, domain %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, name))
+	return resourceServiceDomainRead(d, meta)
+}
+
+func resourceServiceDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	name := d.Get("name").(string)
+
+	version, err := readServiceVersion(conn, serviceID, d.Get("activate").(bool))
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Fastly Service (%s): %s", serviceID, err)
+	}
+
+	log.Printf("[DEBUG] Refreshing Domain (%s) for (%s), version (%d)", name, serviceID, version)
+	domain, err := conn.GetDomain(&gofastly.GetDomainInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			log.Printf("[WARN] Domain (%s) not found for service (%s), removing from state", name, serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", normalizeDomainName(domain.Name))
+	d.Set("comment", domain.Comment)
+
+	return nil
+}
+
+func resourceServiceDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.UpdateDomainInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		}
+
+		if d.HasChange("comment") {
+			opts.Comment = gofastly.String(d.Get("comment").(string))
+		}
+
+		log.Printf("[DEBUG] Update Domain Opts: %#v", opts)
+		_, err := conn.UpdateDomain(&opts)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating domain: service %s, domain %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	return resourceServiceDomainRead(d, meta)
+}
+
+func resourceServiceDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.DeleteDomainInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		}
+
+		log.Printf("[DEBUG] Fastly Domain removal opts: %#v", opts)
+		err := conn.DeleteDomain(&opts)
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting domain: service %s, domain %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceDomainImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.SplitN(d.Id(), "/", 2)
+
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[domain_name]", d.Id())
+	}
+
+	if err := d.Set("service_id", split[0]); err != nil {
+		return nil, fmt.Errorf("Error importing domain: service %s, domain %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("name", split[1]); err != nil {
+		return nil, fmt.Errorf("Error importing domain: service %s, domain %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("activate", true); err != nil {
+		return nil, fmt.Errorf("Error importing domain: service %s, domain %s, %s", split[0], split[1], err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,102 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccFastlyServiceDomain_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDomainConfig(serviceName, domainName, "tf-testing-domain"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceDomainRemoteState("fastly_service_v1.foo", domainName, "tf-testing-domain"),
+					resource.TestCheckResourceAttr("fastly_service_domain.www", "name", domainName),
+					resource.TestCheckResourceAttr("fastly_service_domain.www", "comment", "tf-testing-domain"),
+				),
+			},
+			{
+				Config: testAccServiceDomainConfig(serviceName, domainName, "tf-testing-updated-comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceDomainRemoteState("fastly_service_v1.foo", domainName, "tf-testing-updated-comment"),
+					resource.TestCheckResourceAttr("fastly_service_domain.www", "comment", "tf-testing-updated-comment"),
+				),
+			},
+			{
+				ResourceName:            "fastly_service_domain.www",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate", "allow_takeover"},
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceDomainRemoteState(serviceResource, domainName, comment string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serviceResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceResource)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		version, err := readServiceVersion(conn, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+
+		domain, err := conn.GetDomain(&gofastly.GetDomainInput{
+			ServiceID:      rs.Primary.ID,
+			ServiceVersion: version,
+			Name:           domainName,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Domain for (%s), version (%v): %s", rs.Primary.ID, version, err)
+		}
+
+		if domain.Comment != comment {
+			return fmt.Errorf("Domain comment mismatch, expected: %s, got: %s", comment, domain.Comment)
+		}
+
+		return nil
+	}
+}
+
+// Domains are excluded from the service's managed_block_types allow-list so
+// the standalone resource owns the real domain and the two do not fight over
+// it; the domain block only satisfies the schema. Activation is left off so
+// no backend is required.
+func testAccServiceDomainConfig(serviceName, domain, comment string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name = "unmanaged.example.com"
+  }
+
+  managed_block_types = ["backend"]
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_service_domain" "www" {
+  service_id = fastly_service_v1.foo.id
+  name       = "%s"
+  comment    = "%s"
+  activate   = false
+}`, serviceName, domain, comment)
+}
@@ -35,17 +35,41 @@ func resourceServiceDynamicSnippetContentV1() *schema.Resource {
 				Required:    true,
 				Description: "The VCL code that specifies exactly what the snippet does",
 			},
+
+			"normalize_content": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Normalize `content` before uploading: CRLF line endings become LF and trailing newlines are reduced to one, so editor and platform differences do not show as drift. Default `false`",
+			},
+
+			"ignore_content_drift": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Do not refresh `content` from the API, for dynamic snippets whose content is intentionally updated by another system; the configured content is only pushed when it changes in Terraform. Default `false`",
+			},
 		},
 	}
 }
 
 func resourceServiceDynamicSnippetV1Create(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	snippetID := d.Get("snippet_id").(string)
 	content := d.Get("content").(string)
+	if d.Get("normalize_content").(bool) {
+		content = normalizeSnippetContent(content)
+	}
 
 	_, err := conn.UpdateDynamicSnippet(&gofastly.UpdateDynamicSnippetInput{
 		ServiceID: serviceID,
@@ -67,14 +91,24 @@ func resourceServiceDynamicSnippetV1Create(d *schema.ResourceData, meta interfac
 
 func resourceServiceDynamicSnippetV1Update(d *schema.ResourceData, meta interface{}) error {
 
-	conn := meta.(*FastlyClient).conn
+	client := meta.(*FastlyClient)
+	conn := client.conn
 
 	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
 	snippetID := d.Get("snippet_id").(string)
 
 	if d.HasChange("content") {
 
 		content := d.Get("content").(string)
+		if d.Get("normalize_content").(bool) {
+			content = normalizeSnippetContent(content)
+		}
 
 		_, err := conn.UpdateDynamicSnippet(&gofastly.UpdateDynamicSnippetInput{
 			ServiceID: serviceID,
@@ -104,6 +138,22 @@ func resourceServiceDynamicSnippetV1Read(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	// When another system manages the content, keep the state as-is so an
+	// out-of-band edit does not show as drift. The configured content is still
+	// pushed whenever it changes in Terraform.
+	if d.Get("ignore_content_drift").(bool) {
+		if _, ok := d.GetOk("content"); ok {
+			return nil
+		}
+	}
+
+	// When normalizing, keep the configured form of equivalent content so a
+	// CRLF or trailing-newline difference does not show as drift.
+	stateContent := d.Get("content").(string)
+	if d.Get("normalize_content").(bool) && normalizeSnippetContent(stateContent) == dynamicSnippet.Content {
+		return nil
+	}
+
 	err = d.Set("content", dynamicSnippet.Content)
 	if err != nil {
 		return err
@@ -121,7 +171,7 @@ func resourceServiceDynamicSnippetV1Delete(d *schema.ResourceData, meta interfac
 func resourceServiceDynamicSnippetContentV1Import(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	split := strings.Split(d.Id(), "/")
 
-	if len(split) != 2 {
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
 		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[snippet_id]", d.Id())
 	}
 
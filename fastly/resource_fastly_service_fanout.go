@@ -0,0 +1,13 @@
+package fastly
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceServiceFanout enables the Fanout (GRIP) product on a Compute
+// service so realtime/push applications can be provisioned end-to-end in
+// Terraform. Fanout is only available on Compute services; the API rejects
+// enablement on VCL services.
+func resourceServiceFanout() *schema.Resource {
+	return resourceProductEnablement("fanout")
+}
@@ -0,0 +1,63 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// Fanout is Compute-only and requires the product to be available on the
+// account, so this is only exercised on suitably entitled accounts.
+func TestAccFastlyServiceFanout_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceFanoutConfig(serviceName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("fastly_service_fanout.fanout", "service_id", "fastly_service_compute.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "fastly_service_fanout.fanout",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServiceFanoutConfig(serviceName, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_compute" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  package {
+    filename         = "test_fixtures/package/valid.tar.gz"
+    source_code_hash = filesha512("test_fixtures/package/valid.tar.gz")
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_service_fanout" "fanout" {
+  service_id = fastly_service_compute.foo.id
+}`, serviceName, domain)
+}
@@ -0,0 +1,254 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceServiceSnippet manages a single regular VCL snippet on a service as
+// a standalone resource, keyed by service ID and snippet name. It lets teams
+// split snippet ownership across workspaces (for example a security team's
+// blocking rules next to an app team's routing rules) while targeting the
+// same service; version cloning is coordinated through the shared service
+// lock. Each apply clones the active version, modifies the snippet and,
+// unless `activate` is false, activates the result.
+//
+// A snippet must not be managed both here and in a `snippet` block of the
+// service resource, or the two will fight over it. For dynamic snippets use
+// `fastly_service_dynamic_snippet_content_v1`.
+func resourceServiceSnippet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceSnippetCreate,
+		Read:   resourceServiceSnippetRead,
+		Update: resourceServiceSnippetUpdate,
+		Delete: resourceServiceSnippetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceSnippetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the Service that the Snippet belongs to",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A name that is unique across \"regular\" and \"dynamic\" VCL Snippet configuration blocks. It is important to note that changing this attribute will delete and recreate the resource",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The location in generated VCL where the snippet should be placed (can be one of `init`, `recv`, `hit`, `miss`, `pass`, `fetch`, `error`, `deliver`, `log` or `none`)",
+				ValidateFunc: validateSnippetType(),
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VCL code that specifies exactly what the snippet does",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "Priority determines the ordering for multiple snippets. Lower numbers execute first. Defaults to `100`",
+			},
+			"normalize_content": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Normalize `content` before uploading: CRLF line endings become LF and trailing newlines are reduced to one, so editor and platform differences do not show as drift. Default `false`",
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to activate the new service version after a change to this Snippet. Default `true`",
+			},
+		},
+	}
+}
+
+func resourceServiceSnippetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+	content := d.Get("content").(string)
+	if d.Get("normalize_content").(bool) {
+		content = normalizeSnippetContent(content)
+	}
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.CreateSnippetInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+			Type:           gofastly.SnippetType(d.Get("type").(string)),
+			Priority:       d.Get("priority").(int),
+			Content:        content,
+		}
+
+		log.Printf("[DEBUG] Fastly VCL Snippet Addition opts: %#v", opts)
+		_, err := conn.CreateSnippet(&opts)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating snippet: service %s, snippet %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId(fmt.Sprintf("%s/%s", serviceID, name))
+	return resourceServiceSnippetRead(d, meta)
+}
+
+func resourceServiceSnippetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	name := d.Get("name").(string)
+
+	version, err := readServiceVersion(conn, serviceID, d.Get("activate").(bool))
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up Fastly Service (%s): %s", serviceID, err)
+	}
+
+	log.Printf("[DEBUG] Refreshing VCL Snippet (%s) for (%s), version (%d)", name, serviceID, version)
+	snippet, err := conn.GetSnippet(&gofastly.GetSnippetInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+		Name:           name,
+	})
+	if err != nil {
+		if e, ok := err.(*gofastly.HTTPError); ok && e.IsNotFound() {
+			log.Printf("[WARN] VCL Snippet (%s) not found for service (%s), removing from state", name, serviceID)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("type", string(snippet.Type))
+	d.Set("priority", snippet.Priority)
+
+	// When normalizing, keep the configured form of equivalent content so a
+	// CRLF or trailing-newline difference does not show as drift.
+	stateContent := d.Get("content").(string)
+	if d.Get("normalize_content").(bool) && normalizeSnippetContent(stateContent) == snippet.Content {
+		d.Set("content", stateContent)
+	} else {
+		d.Set("content", snippet.Content)
+	}
+
+	return nil
+}
+
+func resourceServiceSnippetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+	content := d.Get("content").(string)
+	if d.Get("normalize_content").(bool) {
+		content = normalizeSnippetContent(content)
+	}
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.UpdateSnippetInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+			NewName:        name,
+			Type:           gofastly.SnippetType(d.Get("type").(string)),
+			Priority:       d.Get("priority").(int),
+			Content:        content,
+		}
+
+		log.Printf("[DEBUG] Update VCL Snippet Opts: %#v", opts)
+		_, err := conn.UpdateSnippet(&opts)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating snippet: service %s, snippet %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	return resourceServiceSnippetRead(d, meta)
+}
+
+func resourceServiceSnippetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*FastlyClient)
+	conn := client.conn
+
+	serviceID := d.Get("service_id").(string)
+
+	// Serialize against the service resource and other nested resources
+	// operating on the same service.
+	client.serviceLocks.Lock(serviceID)
+	defer client.serviceLocks.Unlock(serviceID)
+
+	name := d.Get("name").(string)
+
+	_, err := modifyServiceVersion(conn, serviceID, d.Get("activate").(bool), func(version int) error {
+		opts := gofastly.DeleteSnippetInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+			Name:           name,
+		}
+
+		log.Printf("[DEBUG] Fastly VCL Snippet removal opts: %#v", opts)
+		err := conn.DeleteSnippet(&opts)
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.StatusCode == 404 {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting snippet: service %s, snippet %s, %s", serviceID, name, err)
+	}
+
+	client.serviceDetails.Invalidate(serviceID)
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceSnippetImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	split := strings.SplitN(d.Id(), "/", 2)
+
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("Invalid id: %s. The ID should be in the format [service_id]/[snippet_name]", d.Id())
+	}
+
+	if err := d.Set("service_id", split[0]); err != nil {
+		return nil, fmt.Errorf("Error importing snippet: service %s, snippet %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("name", split[1]); err != nil {
+		return nil, fmt.Errorf("Error importing snippet: service %s, snippet %s, %s", split[0], split[1], err)
+	}
+	if err := d.Set("activate", true); err != nil {
+		return nil, fmt.Errorf("Error importing snippet: service %s, snippet %s, %s", split[0], split[1], err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,107 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccFastlyServiceSnippet_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+	snippetName := fmt.Sprintf("tf_snippet_%s", acctest.RandString(10))
+
+	contentCreate := "# testing\\nset req.http.X-Test = \"create\";"
+	contentUpdate := "# testing\\nset req.http.X-Test = \"update\";"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSnippetConfig(serviceName, domainName, snippetName, "recv", contentCreate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceSnippetRemoteState("fastly_service_v1.foo", snippetName, "recv"),
+					resource.TestCheckResourceAttr("fastly_service_snippet.rules", "name", snippetName),
+					resource.TestCheckResourceAttr("fastly_service_snippet.rules", "type", "recv"),
+					resource.TestCheckResourceAttr("fastly_service_snippet.rules", "priority", "100"),
+				),
+			},
+			{
+				Config: testAccServiceSnippetConfig(serviceName, domainName, snippetName, "fetch", contentUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyServiceSnippetRemoteState("fastly_service_v1.foo", snippetName, "fetch"),
+					resource.TestCheckResourceAttr("fastly_service_snippet.rules", "type", "fetch"),
+				),
+			},
+			{
+				ResourceName:            "fastly_service_snippet.rules",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activate", "normalize_content"},
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceSnippetRemoteState(serviceResource, snippetName, snippetType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serviceResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceResource)
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		version, err := readServiceVersion(conn, rs.Primary.ID, false)
+		if err != nil {
+			return err
+		}
+
+		snippet, err := conn.GetSnippet(&gofastly.GetSnippetInput{
+			ServiceID:      rs.Primary.ID,
+			ServiceVersion: version,
+			Name:           snippetName,
+		})
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up VCL Snippet for (%s), version (%v): %s", rs.Primary.ID, version, err)
+		}
+
+		if string(snippet.Type) != snippetType {
+			return fmt.Errorf("Snippet type mismatch, expected: %s, got: %s", snippetType, snippet.Type)
+		}
+
+		return nil
+	}
+}
+
+// The service intentionally has no snippet blocks: the standalone resource
+// owns the snippet, and the service resource skips refreshing block types it
+// does not manage, so the two do not fight over it. Activation is left off so
+// no backend is required.
+func testAccServiceSnippetConfig(serviceName, domain, snippetName, snippetType, content string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  activate      = false
+  force_destroy = true
+}
+
+resource "fastly_service_snippet" "rules" {
+  service_id = fastly_service_v1.foo.id
+  name       = "%s"
+  type       = "%s"
+  content    = "%s"
+  activate   = false
+}`, serviceName, domain, snippetName, snippetType, content)
+}
@@ -61,5 +61,14 @@ var vclService = &BaseServiceDefinition{
 }
 
 func resourceServiceV1() *schema.Resource {
+	s := resourceService(vclService)
+	s.DeprecationMessage = "fastly_service_v1 has been renamed to fastly_service_vcl. The schemas are identical; to migrate, update the resource type in your configuration and move the existing state entry with `terraform state mv fastly_service_v1.<name> fastly_service_vcl.<name>` (or remove and re-import the service)."
+	return s
+}
+
+// resourceServiceVCL is the properly named successor to resourceServiceV1. Both
+// share the same service definition, so state moved between them needs no
+// transformation.
+func resourceServiceVCL() *schema.Resource {
 	return resourceService(vclService)
 }
@@ -0,0 +1,12 @@
+package fastly
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceServiceWebSockets enables the WebSockets product on a service so
+// applications needing WebSocket passthrough do not require a manual
+// enablement step after every service recreation.
+func resourceServiceWebSockets() *schema.Resource {
+	return resourceProductEnablement("websockets")
+}
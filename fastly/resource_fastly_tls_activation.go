@@ -63,7 +63,11 @@ func resourceFastlyTLSActivationCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(activation.ID)
 
-	return resourceFastlyTLSActivationRead(d, meta)
+	// The new object is occasionally not yet visible to reads; retry briefly
+	// rather than failing the apply with a tainted resource.
+	return retryNotFoundAfterCreate(func() error {
+		return resourceFastlyTLSActivationRead(d, meta)
+	})
 }
 
 func resourceFastlyTLSActivationRead(d *schema.ResourceData, meta interface{}) error {
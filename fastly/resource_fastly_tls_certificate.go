@@ -30,6 +30,13 @@ func resourceFastlyTLSCertificate() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validatePEMBlock("CERTIFICATE"),
 			},
+			"rotation_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, force the certificate to be replaced. Drive rotation by bumping a value here (e.g. a date or counter) instead of tainting the resource.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: "Timestamp (GMT) when the certificate was created.",
@@ -93,7 +100,11 @@ func resourceFastlyTLSCertificateCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(output.ID)
 
-	return resourceFastlyTLSCertificateRead(d, meta)
+	// The new object is occasionally not yet visible to reads; retry briefly
+	// rather than failing the apply with a tainted resource.
+	return retryNotFoundAfterCreate(func() error {
+		return resourceFastlyTLSCertificateRead(d, meta)
+	})
 }
 
 func resourceFastlyTLSCertificateRead(d *schema.ResourceData, meta interface{}) error {
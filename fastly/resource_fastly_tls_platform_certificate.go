@@ -95,7 +95,11 @@ func resourceFastlyTLSPlatformCertificateCreate(d *schema.ResourceData, meta int
 
 	d.SetId(certificate.ID)
 
-	return resourceFastlyTLSPlatformCertificateRead(d, meta)
+	// The new object is occasionally not yet visible to reads; retry briefly
+	// rather than failing the apply with a tainted resource.
+	return retryNotFoundAfterCreate(func() error {
+		return resourceFastlyTLSPlatformCertificateRead(d, meta)
+	})
 }
 
 func resourceFastlyTLSPlatformCertificateRead(d *schema.ResourceData, meta interface{}) error {
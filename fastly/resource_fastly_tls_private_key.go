@@ -1,9 +1,11 @@
 package fastly
 
 import (
+	"fmt"
+	"time"
+
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"time"
 )
 
 func resourceFastlyTLSPrivateKey() *schema.Resource {
@@ -16,11 +18,26 @@ func resourceFastlyTLSPrivateKey() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Private key in PEM format. One of `key_pem` or `key_pem_file` must be set.",
+				Sensitive:     true,
+				ConflictsWith: []string{"key_pem_file"},
+			},
+			"key_pem_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Path to a local file containing the private key in PEM format, read at apply time, for policies that forbid PEM material in state files. Only a SHA-256 of the content is kept in state.",
+				ConflictsWith: []string{"key_pem"},
+			},
+			"key_pem_sha256": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				ForceNew:    true,
-				Description: "Private key in PEM format.",
-				Sensitive:   true,
+				Description: "SHA-256 of the private key content. When using `key_pem_file`, set this to `filesha256(...)` of the file so key rotation is detected.",
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -28,6 +45,13 @@ func resourceFastlyTLSPrivateKey() *schema.Resource {
 				ForceNew:    true,
 				Description: "Customisable name of the private key.",
 			},
+			"rotation_triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, force the key to be replaced. Drive rotation by bumping a value here (e.g. a date or counter) instead of tainting the resource.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -60,8 +84,16 @@ func resourceFastlyTLSPrivateKey() *schema.Resource {
 func resourceFastlyTLSPrivateKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*FastlyClient).conn
 
+	key, err := fileBackedValue("key_pem", d.Get("key_pem").(string), d.Get("key_pem_file").(string))
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		return fmt.Errorf("one of key_pem or key_pem_file must be set")
+	}
+
 	privateKey, err := conn.CreatePrivateKey(&gofastly.CreatePrivateKeyInput{
-		Key:  d.Get("key_pem").(string),
+		Key:  key,
 		Name: d.Get("name").(string),
 	})
 	if err != nil {
@@ -69,8 +101,13 @@ func resourceFastlyTLSPrivateKeyCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(privateKey.ID)
+	d.Set("key_pem_sha256", secretSHA256(key))
 
-	return resourceFastlyTLSPrivateKeyRead(d, meta)
+	// The new object is occasionally not yet visible to reads; retry briefly
+	// rather than failing the apply with a tainted resource.
+	return retryNotFoundAfterCreate(func() error {
+		return resourceFastlyTLSPrivateKeyRead(d, meta)
+	})
 }
 
 func resourceFastlyTLSPrivateKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -67,6 +67,31 @@ func resourceFastlyTLSSubscription() *schema.Resource {
 				Description: "The details required to configure DNS to respond to ACME DNS challenge in order to verify domain ownership.",
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
+				Deprecated:  "Use managed_dns_challenges instead, which contains the challenge for every domain on the subscription.",
+			},
+			"managed_dns_challenges": {
+				Type:        schema.TypeSet,
+				Description: "A list of the DNS records to add to respond to the ACME DNS challenges, one per domain on the subscription. Suited to creating Route53/Cloudflare records with a `for_each`.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"record_type": {
+							Type:        schema.TypeString,
+							Description: "The type of DNS record to add, e.g. `CNAME`.",
+							Computed:    true,
+						},
+						"record_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the DNS record to add, e.g. `_acme-challenge.example.com`.",
+							Computed:    true,
+						},
+						"record_value": {
+							Type:        schema.TypeString,
+							Description: "The value to which the DNS record should point.",
+							Computed:    true,
+						},
+					},
+				},
 			},
 			"managed_http_challenges": {
 				Type:        schema.TypeSet,
@@ -140,7 +165,11 @@ func resourceFastlyTLSSubscriptionCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(subscription.ID)
 
-	return resourceFastlyTLSSubscriptionRead(d, meta)
+	// The new object is occasionally not yet visible to reads; retry briefly
+	// rather than failing the apply with a tainted resource.
+	return retryNotFoundAfterCreate(func() error {
+		return resourceFastlyTLSSubscriptionRead(d, meta)
+	})
 }
 
 func resourceFastlyTLSSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
@@ -161,24 +190,37 @@ func resourceFastlyTLSSubscriptionRead(d *schema.ResourceData, meta interface{})
 	}
 
 	var managedHTTPChallenges []map[string]interface{}
+	var managedDNSChallenges []map[string]interface{}
 	var managedDNSChallenge map[string]string
-	for _, challenge := range subscription.Authorizations[0].Challenges {
-		if challenge.Type == "managed-dns" {
-			if len(challenge.Values) < 1 {
-				return fmt.Errorf("Fastly API returned no record values for Managed DNS Challenge")
-			}
+	for _, authorization := range subscription.Authorizations {
+		for _, challenge := range authorization.Challenges {
+			if challenge.Type == "managed-dns" {
+				if len(challenge.Values) < 1 {
+					return fmt.Errorf("Fastly API returned no record values for Managed DNS Challenge")
+				}
+
+				managedDNSChallenges = append(managedDNSChallenges, map[string]interface{}{
+					"record_type":  challenge.RecordType,
+					"record_name":  challenge.RecordName,
+					"record_value": challenge.Values[0],
+				})
 
-			managedDNSChallenge = map[string]string{
-				"record_type":  challenge.RecordType,
-				"record_name":  challenge.RecordName,
-				"record_value": challenge.Values[0],
+				// The deprecated single-challenge map only reflects the first
+				// authorization, which is all it ever could represent.
+				if managedDNSChallenge == nil {
+					managedDNSChallenge = map[string]string{
+						"record_type":  challenge.RecordType,
+						"record_name":  challenge.RecordName,
+						"record_value": challenge.Values[0],
+					}
+				}
+			} else {
+				managedHTTPChallenges = append(managedHTTPChallenges, map[string]interface{}{
+					"record_type":   challenge.RecordType,
+					"record_name":   challenge.RecordName,
+					"record_values": challenge.Values,
+				})
 			}
-		} else {
-			managedHTTPChallenges = append(managedHTTPChallenges, map[string]interface{}{
-				"record_type":   challenge.RecordType,
-				"record_name":   challenge.RecordName,
-				"record_values": challenge.Values,
-			})
 		}
 	}
 
@@ -214,6 +256,10 @@ func resourceFastlyTLSSubscriptionRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	err = d.Set("managed_dns_challenges", managedDNSChallenges)
+	if err != nil {
+		return err
+	}
 	err = d.Set("managed_http_challenges", managedHTTPChallenges)
 	if err != nil {
 		return err
@@ -0,0 +1,42 @@
+package fastly
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+)
+
+const (
+	// postCreateReadAttempts is how many times a read immediately following a
+	// create is attempted before a 404 is treated as real.
+	postCreateReadAttempts = 4
+
+	// postCreateReadBackoff is the base delay between post-create read
+	// attempts; it grows linearly with each attempt.
+	postCreateReadBackoff = 2 * time.Second
+)
+
+// retryNotFoundAfterCreate runs a read function, retrying with a short backoff
+// when it fails with a 404 from the Fastly API. Newly created objects are
+// occasionally not yet visible to reads, which would otherwise fail the apply
+// and leave the resource tainted.
+func retryNotFoundAfterCreate(f func() error) error {
+	var err error
+	for attempt := 0; attempt < postCreateReadAttempts; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(attempt) * postCreateReadBackoff
+			log.Printf("[DEBUG] Read after create returned 404, retrying in %s", backoff)
+			time.Sleep(backoff)
+		}
+
+		err = f()
+
+		var httpErr *gofastly.HTTPError
+		if err == nil || !errors.As(err, &httpErr) || !httpErr.IsNotFound() {
+			return err
+		}
+	}
+	return err
+}
@@ -0,0 +1,100 @@
+package fastly
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	// serverErrorMaxRetries is how many times a single request is retried
+	// after a transient 5xx before the error is returned to the caller.
+	serverErrorMaxRetries = 3
+
+	// serverErrorBaseBackoff is the backoff before the first retry; it doubles
+	// on each subsequent attempt.
+	serverErrorBaseBackoff = time.Second
+)
+
+// serverErrorRetryTransport retries requests that fail with a transient 5xx,
+// which the Fastly API occasionally returns during maintenance. Only
+// idempotent methods are retried — a POST that returned 502 may still have
+// been applied, so replaying it could, for example, clone a second version.
+type serverErrorRetryTransport struct {
+	transport http.RoundTripper
+
+	// baseBackoff overrides serverErrorBaseBackoff when non-zero; tests use it
+	// to avoid multi-second sleeps.
+	baseBackoff time.Duration
+}
+
+func (t *serverErrorRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !idempotentMethod(req.Method) {
+		return t.transport.RoundTrip(req)
+	}
+
+	// Buffer the request body (they are small API payloads) so the request
+	// can be replayed on retry.
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		if body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.transport.RoundTrip(req)
+		if err != nil || !transientServerError(resp.StatusCode) || attempt >= serverErrorMaxRetries {
+			return resp, err
+		}
+		resp.Body.Close()
+
+		base := t.baseBackoff
+		if base == 0 {
+			base = serverErrorBaseBackoff
+		}
+		wait := serverErrorBackoff(base, attempt)
+		log.Printf("[WARN] Fastly API returned %d for %s %s, retrying in %s", resp.StatusCode, req.Method, req.URL.Path, wait)
+		time.Sleep(wait)
+	}
+}
+
+// idempotentMethod reports whether a request using the method can be safely
+// replayed.
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// transientServerError reports whether a status code indicates a server-side
+// failure worth retrying. 501 Not Implemented is deliberately excluded; a
+// retry cannot make the endpoint exist.
+func transientServerError(code int) bool {
+	switch code {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// serverErrorBackoff returns the wait before retry n (zero-based): the base
+// backoff doubled per attempt, with up to 50% random jitter added so callers
+// retrying in lockstep spread out.
+func serverErrorBackoff(base time.Duration, attempt int) time.Duration {
+	backoff := base << uint(attempt)
+	jitter := time.Duration(rand.Int63n(int64(backoff) / 2))
+	return backoff + jitter
+}
@@ -0,0 +1,85 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerErrorRetryTransport(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &serverErrorRetryTransport{transport: http.DefaultTransport, baseBackoff: time.Millisecond},
+	}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, requests)
+}
+
+func TestServerErrorRetryTransportGivesUp(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &serverErrorRetryTransport{transport: http.DefaultTransport, baseBackoff: time.Millisecond},
+	}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, serverErrorMaxRetries+1, requests)
+}
+
+func TestServerErrorRetryTransportSkipsPOST(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: &serverErrorRetryTransport{transport: http.DefaultTransport, baseBackoff: time.Millisecond},
+	}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, requests)
+}
+
+func TestServerErrorBackoff(t *testing.T) {
+	for attempt := 0; attempt <= serverErrorMaxRetries; attempt++ {
+		min := serverErrorBaseBackoff << uint(attempt)
+		max := min + min/2
+		wait := serverErrorBackoff(serverErrorBaseBackoff, attempt)
+		assert.GreaterOrEqual(t, int64(wait), int64(min))
+		assert.LessOrEqual(t, int64(wait), int64(max))
+	}
+}
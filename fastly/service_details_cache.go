@@ -0,0 +1,55 @@
+package fastly
+
+import (
+	"log"
+	"sync"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+)
+
+// serviceDetailsCache memoizes GetServiceDetails responses for the lifetime
+// of a provider instance. Several code paths (refresh, delete, data sources)
+// look up the same service details during a single Terraform operation;
+// caching avoids repeating the round trip. Entries are invalidated whenever
+// the provider mutates the service.
+type serviceDetailsCache struct {
+	mu      sync.Mutex
+	details map[string]*gofastly.ServiceDetail
+}
+
+func newServiceDetailsCache() *serviceDetailsCache {
+	return &serviceDetailsCache{details: make(map[string]*gofastly.ServiceDetail)}
+}
+
+// Get returns the details for a service, fetching them from the API on a
+// cache miss.
+func (c *serviceDetailsCache) Get(conn *gofastly.Client, serviceID string) (*gofastly.ServiceDetail, error) {
+	c.mu.Lock()
+	if s, ok := c.details[serviceID]; ok {
+		c.mu.Unlock()
+		log.Printf("[DEBUG] Using cached service details for (%s)", serviceID)
+		return s, nil
+	}
+	c.mu.Unlock()
+
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: serviceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.details[serviceID] = s
+	c.mu.Unlock()
+
+	return s, nil
+}
+
+// Invalidate drops the cached details for a service after it has been
+// mutated.
+func (c *serviceDetailsCache) Invalidate(serviceID string) {
+	c.mu.Lock()
+	delete(c.details, serviceID)
+	c.mu.Unlock()
+}
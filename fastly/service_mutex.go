@@ -0,0 +1,40 @@
+package fastly
+
+import "sync"
+
+// serviceMutex provides named locks keyed by service ID. Resources that
+// modify the same service (the service resource itself, ACL entries,
+// dictionary items and dynamic snippet content) acquire the lock for their
+// service ID so concurrent operations within a single Terraform run are
+// serialized instead of racing each other against the Fastly API.
+type serviceMutex struct {
+	mu    sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func newServiceMutex() *serviceMutex {
+	return &serviceMutex{locks: make(map[string]*sync.Mutex)}
+}
+
+// Lock acquires the lock for the given service ID, blocking until it is
+// available.
+func (m *serviceMutex) Lock(serviceID string) {
+	m.get(serviceID).Lock()
+}
+
+// Unlock releases the lock for the given service ID.
+func (m *serviceMutex) Unlock(serviceID string) {
+	m.get(serviceID).Unlock()
+}
+
+func (m *serviceMutex) get(serviceID string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lock, ok := m.locks[serviceID]
+	if !ok {
+		lock = &sync.Mutex{}
+		m.locks[serviceID] = lock
+	}
+	return lock
+}
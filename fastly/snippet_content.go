@@ -0,0 +1,16 @@
+package fastly
+
+import "strings"
+
+// normalizeSnippetContent converts CRLF line endings to LF and reduces any
+// trailing newlines to exactly one. Editors, heredocs and files checked out
+// through Windows git disagree on exactly these two points, and either
+// difference is an invisible permanent diff against what the API stores.
+func normalizeSnippetContent(content string) string {
+	if content == "" {
+		return ""
+	}
+	content = strings.Replace(content, "\r\n", "\n", -1)
+	content = strings.TrimRight(content, "\n")
+	return content + "\n"
+}
@@ -0,0 +1,25 @@
+package fastly
+
+import "testing"
+
+func TestNormalizeSnippetContent(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		in   string
+		want string
+	}{
+		"empty":                 {"", ""},
+		"already normalized":    {"set req.http.X = \"1\";\n", "set req.http.X = \"1\";\n"},
+		"missing newline":       {"set req.http.X = \"1\";", "set req.http.X = \"1\";\n"},
+		"extra newlines":        {"set req.http.X = \"1\";\n\n\n", "set req.http.X = \"1\";\n"},
+		"crlf":                  {"if (req.url ~ \"^/a\") {\r\n  set req.http.X = \"1\";\r\n}\r\n", "if (req.url ~ \"^/a\") {\n  set req.http.X = \"1\";\n}\n"},
+		"only newlines":         {"\n\n", "\n"},
+		"interior blank lines":  {"a\n\nb\n", "a\n\nb\n"},
+		"crlf without trailing": {"a\r\nb", "a\nb\n"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := normalizeSnippetContent(testCase.in); got != testCase.want {
+				t.Errorf("normalizeSnippetContent(%q) = %q, want %q", testCase.in, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package fastly
+
+import (
+	"sync"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+)
+
+// staticDataCache memoizes API responses for data that does not change within
+// a Terraform run, such as the public IP ranges. Configurations commonly
+// instantiate these data sources multiple times (e.g. once per module), and
+// without memoization each instance performs its own identical API call.
+var staticDataCache struct {
+	mu sync.Mutex
+
+	ipsFetched    bool
+	ipv4Addresses gofastly.IPAddrs
+	ipv6Addresses gofastly.IPAddrs
+}
+
+// allIPsCached returns the Fastly public IP ranges, performing the API call
+// at most once per provider process.
+func allIPsCached(conn *gofastly.Client) (gofastly.IPAddrs, gofastly.IPAddrs, error) {
+	staticDataCache.mu.Lock()
+	defer staticDataCache.mu.Unlock()
+
+	if staticDataCache.ipsFetched {
+		return staticDataCache.ipv4Addresses, staticDataCache.ipv6Addresses, nil
+	}
+
+	ipv4, ipv6, err := conn.AllIPs()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	staticDataCache.ipsFetched = true
+	staticDataCache.ipv4Addresses = ipv4
+	staticDataCache.ipv6Addresses = ipv6
+	return ipv4, ipv6, nil
+}
@@ -0,0 +1,125 @@
+package fastly
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// apiTelemetryTransport aggregates a per-run summary of Fastly API calls,
+// grouped by endpoint template and response status, and persists it as JSON
+// after every request so the file holds the complete summary once the run
+// finishes. It lets platform teams quantify rate-limit usage and spot
+// pathological refresh patterns without scraping debug logs.
+type apiTelemetryTransport struct {
+	transport http.RoundTripper
+	file      string
+
+	mu     sync.Mutex
+	counts map[telemetryKey]int
+}
+
+type telemetryKey struct {
+	Endpoint string
+	Status   int
+}
+
+func newAPITelemetryTransport(transport http.RoundTripper, file string) *apiTelemetryTransport {
+	return &apiTelemetryTransport{
+		transport: transport,
+		file:      file,
+		counts:    make(map[telemetryKey]int),
+	}
+}
+
+func (t *apiTelemetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+
+	// Transport-level failures are recorded with status 0 so they still show
+	// up in the summary.
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+	t.record(req.Method, req.URL.Path, status)
+
+	return resp, err
+}
+
+func (t *apiTelemetryTransport) record(method, path string, status int) {
+	key := telemetryKey{
+		Endpoint: method + " " + telemetryEndpointTemplate(path),
+		Status:   status,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.counts[key]++
+	t.flushLocked()
+}
+
+// telemetryCall is one line of the persisted summary.
+type telemetryCall struct {
+	Endpoint string `json:"endpoint"`
+	Status   int    `json:"status"`
+	Count    int    `json:"count"`
+}
+
+type telemetrySummary struct {
+	GeneratedAt string          `json:"generated_at"`
+	Total       int             `json:"total"`
+	Calls       []telemetryCall `json:"calls"`
+}
+
+func (t *apiTelemetryTransport) flushLocked() {
+	summary := telemetrySummary{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	for key, count := range t.counts {
+		summary.Total += count
+		summary.Calls = append(summary.Calls, telemetryCall{
+			Endpoint: key.Endpoint,
+			Status:   key.Status,
+			Count:    count,
+		})
+	}
+	sort.Slice(summary.Calls, func(i, j int) bool {
+		a, b := summary.Calls[i], summary.Calls[j]
+		if a.Endpoint != b.Endpoint {
+			return a.Endpoint < b.Endpoint
+		}
+		return a.Status < b.Status
+	})
+
+	b, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		log.Printf("[WARN] Error encoding Fastly API telemetry summary: %s", err)
+		return
+	}
+	if err := ioutil.WriteFile(t.file, append(b, '\n'), 0644); err != nil {
+		log.Printf("[WARN] Error writing Fastly API telemetry summary to %s: %s", t.file, err)
+	}
+}
+
+// telemetryIDSegment matches path segments that are identifiers rather than
+// fixed route words: service/dictionary/ACL IDs (long alphanumeric tokens) and
+// version numbers.
+var telemetryIDSegment = regexp.MustCompile(`^([0-9]+|[0-9a-zA-Z]{16,})$`)
+
+// telemetryEndpointTemplate collapses identifier segments of a request path so
+// calls against different services or versions group under one endpoint.
+func telemetryEndpointTemplate(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if telemetryIDSegment.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
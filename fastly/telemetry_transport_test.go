@@ -0,0 +1,59 @@
+package fastly
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITelemetryTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "fastly-telemetry")
+	assert.NoError(t, err)
+	file := filepath.Join(dir, "telemetry.json")
+
+	client := &http.Client{
+		Transport: newAPITelemetryTransport(http.DefaultTransport, file),
+	}
+
+	for _, path := range []string{
+		"/service/7i6HN3TK9wS159v2gPAZ8A/version/3/backend",
+		"/service/b8AgRLmR6BEdGF1nzDpdcB/version/12/backend",
+	} {
+		resp, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	b, err := ioutil.ReadFile(file)
+	assert.NoError(t, err)
+
+	var summary telemetrySummary
+	assert.NoError(t, json.Unmarshal(b, &summary))
+
+	assert.Equal(t, 2, summary.Total)
+	if assert.Len(t, summary.Calls, 1) {
+		assert.Equal(t, "GET /service/{id}/version/{id}/backend", summary.Calls[0].Endpoint)
+		assert.Equal(t, http.StatusOK, summary.Calls[0].Status)
+		assert.Equal(t, 2, summary.Calls[0].Count)
+	}
+}
+
+func TestTelemetryEndpointTemplate(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/service/7i6HN3TK9wS159v2gPAZ8A/version/3/domain": "/service/{id}/version/{id}/domain",
+		"/tokens/self": "/tokens/self",
+		"/service/7i6HN3TK9wS159v2gPAZ8A/dictionary/3vBwyLJRTLzvS8uq9DGvSb/item": "/service/{id}/dictionary/{id}/item",
+	} {
+		assert.Equal(t, expected, telemetryEndpointTemplate(path))
+	}
+}
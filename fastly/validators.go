@@ -1,17 +1,41 @@
 package fastly
 
 import (
+	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
 	"strings"
 
+	"golang.org/x/net/idna"
+
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func validateLoggingFormatVersion() schema.SchemaValidateFunc {
-	return validation.IntBetween(1, 2)
+	return func(val interface{}, key string) ([]string, []error) {
+		warns, errs := validation.IntBetween(1, 2)(val, key)
+		if len(errs) > 0 {
+			return warns, errs
+		}
+
+		// Fastly is sunsetting version 1 log formats. Warn by default so
+		// existing configurations keep applying, and allow opting into a hard
+		// failure ahead of the removal.
+		if val.(int) == 1 {
+			msg := fmt.Sprintf("%s 1 is deprecated: Fastly is sunsetting version 1 log formats, and the service will eventually be upgraded to version 2 automatically, which changes the variables available to `format`. Set `format_version = 2` and update `format` accordingly", key)
+			if os.Getenv("FASTLY_FORBID_FORMAT_VERSION_1") != "" {
+				return warns, []error{fmt.Errorf(msg)}
+			}
+			warns = append(warns, msg)
+		}
+		return warns, errs
+	}
 }
 
 func validateLoggingMessageType() schema.SchemaValidateFunc {
@@ -24,9 +48,267 @@ func validateLoggingMessageType() schema.SchemaValidateFunc {
 }
 
 func validateLoggingPlacement() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if v == "waf_debug" {
+			return nil, []error{fmt.Errorf("%s `waf_debug` has been retired by Fastly and can no longer be set; use `none` or omit the attribute", key)}
+		}
+		if v != "none" {
+			return nil, []error{fmt.Errorf("expected %s to be `none`, got %s", key, v)}
+		}
+		return nil, nil
+	}
+}
+
+func validateKafkaCompressionCodec() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
-		"none",
-		"waf_debug",
+		"gzip",
+		"snappy",
+		"lz4",
+	}, false)
+}
+
+// validateKafkaBrokers checks that `brokers` is a comma-separated list of
+// host[:port] entries, catching the common space-separated list mistake before
+// the API rejects it.
+func validateKafkaBrokers() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if strings.TrimSpace(v) == "" {
+			return nil, []error{fmt.Errorf("expected %s to contain at least one broker", key)}
+		}
+
+		for _, broker := range strings.Split(v, ",") {
+			broker = strings.TrimSpace(broker)
+			if broker == "" {
+				return nil, []error{fmt.Errorf("expected %s to be a comma-separated list of host[:port] entries, got an empty entry", key)}
+			}
+			if strings.ContainsAny(broker, " \t") {
+				return nil, []error{fmt.Errorf("expected %s to be a comma-separated list of host[:port] entries, got space-separated entry %q", key, broker)}
+			}
+
+			host := broker
+			if i := strings.LastIndex(broker, ":"); i != -1 {
+				host = broker[:i]
+				port := broker[i+1:]
+				if _, err := strconv.Atoi(port); err != nil || port == "" {
+					return nil, []error{fmt.Errorf("expected %s entry %q to have a numeric port", key, broker)}
+				}
+			}
+			if host == "" {
+				return nil, []error{fmt.Errorf("expected %s entry %q to have a host", key, broker)}
+			}
+		}
+		return nil, nil
+	}
+}
+
+// validateDomainName checks a domain at plan time so obviously invalid FQDNs
+// fail before the API sees them. A single leading `*.` wildcard label is
+// allowed, and internationalized names are accepted with a warning that they
+// will be stored in punycode form (see normalizeDomainName).
+func validateDomainName() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		var warns []string
+
+		name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(val.(string))), ".")
+		if name == "" {
+			return nil, []error{fmt.Errorf("expected %s to be a fully qualified domain name", key)}
+		}
+
+		rest := name
+		if strings.HasPrefix(name, "*.") {
+			rest = name[2:]
+		} else if strings.Contains(name, "*") {
+			return nil, []error{fmt.Errorf("expected %s to only use a wildcard as the leftmost label (`*.example.com`), got %q", key, name)}
+		}
+
+		normalized := normalizeDomainName(rest)
+		if normalized != rest {
+			warns = append(warns, fmt.Sprintf("%s %q is an internationalized domain name and will be stored in its punycode form %q", key, rest, normalized))
+		} else if _, err := idna.ToASCII(rest); err != nil {
+			return warns, []error{fmt.Errorf("expected %s to be a valid domain name: %s", key, err)}
+		}
+
+		if len(normalized) > 253 {
+			return warns, []error{fmt.Errorf("expected %s to be at most 253 characters, got %d", key, len(normalized))}
+		}
+
+		labels := strings.Split(normalized, ".")
+		if len(labels) < 2 {
+			return warns, []error{fmt.Errorf("expected %s to be a fully qualified domain name (e.g. `www.example.com`), got %q", key, name)}
+		}
+		for _, label := range labels {
+			if label == "" {
+				return warns, []error{fmt.Errorf("expected %s to not contain empty labels, got %q", key, name)}
+			}
+			if len(label) > 63 {
+				return warns, []error{fmt.Errorf("expected each label of %s to be at most 63 characters, got %q", key, label)}
+			}
+			if !domainLabelRegexp.MatchString(label) {
+				return warns, []error{fmt.Errorf("expected %s to only contain letters, digits and hyphens, with no label starting or ending in a hyphen, got %q", key, name)}
+			}
+		}
+
+		return warns, nil
+	}
+}
+
+var domainLabelRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// validateGzipExtension checks a gzip block `extensions` entry. The API takes
+// the list as a single space-separated string, so whitespace in an entry
+// silently splits it in two; a leading dot (`.css` for `css`) is the other
+// common mistake.
+func validateGzipExtension() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if v == "" {
+			return nil, []error{fmt.Errorf("expected %s to not be empty", key)}
+		}
+		if strings.HasPrefix(v, ".") {
+			return nil, []error{fmt.Errorf("expected %s to be an extension without the leading dot (e.g. `css`), got %q", key, v)}
+		}
+		if strings.ContainsAny(v, " \t") {
+			return nil, []error{fmt.Errorf("expected %s to be a single extension without whitespace, got %q", key, v)}
+		}
+		return nil, nil
+	}
+}
+
+// validateGzipContentType checks a gzip block `content_types` entry is a
+// `type/subtype` media type. As with extensions, the API takes the list as a
+// space-separated string, so an entry containing whitespace corrupts the list.
+func validateGzipContentType() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if strings.ContainsAny(v, " \t") {
+			return nil, []error{fmt.Errorf("expected %s to be a single media type without whitespace, got %q", key, v)}
+		}
+		if !gzipContentTypeRegexp.MatchString(v) {
+			return nil, []error{fmt.Errorf("expected %s to be a `type/subtype` media type (e.g. `text/html`), got %q", key, v)}
+		}
+		return nil, nil
+	}
+}
+
+var gzipContentTypeRegexp = regexp.MustCompile(`^[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+$`)
+
+// validateContentType checks a MIME type of the `type/subtype` form, with
+// optional parameters (e.g. `text/html; charset=utf-8`).
+func validateContentType() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if v == "" {
+			return nil, nil
+		}
+		if !contentTypeRegexp.MatchString(v) {
+			return nil, []error{fmt.Errorf("expected %s to be a media type such as `text/html` or `application/json; charset=utf-8`, got %q", key, v)}
+		}
+		return nil, nil
+	}
+}
+
+var contentTypeRegexp = regexp.MustCompile(`^[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+(\s*;.+)?$`)
+
+// Apache-style directive letters accepted in Fastly log format strings,
+// including the modifiers used after `%{...}` (header, VCL variable, time and
+// cookie lookups).
+const logFormatDirectiveLetters = "aAbBCDefhHiIklmnopPqrsStTuUvVXzOZ"
+
+// VCL variable prefixes recognised inside `%{...}V` directives. Expressions
+// (function calls, string concatenation, conditionals) are left to the API to
+// validate.
+var logFormatVCLPrefixes = []string{
+	"req.", "resp.", "bereq.", "beresp.", "obj.", "client.", "server.",
+	"tls.", "fastly.", "fastly_info.", "time.", "math.", "geoip.", "waf.",
+	"now", "stale.",
+}
+
+// validateLogFormat parses a logging `format` string at plan time, failing on
+// unterminated `%{...}` braces and unknown directive letters, and warning on
+// `%{...}V` variables with an unrecognised prefix. A bad directive otherwise
+// only shows up as garbage log lines at runtime.
+func validateLogFormat() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		var warns []string
+
+		format := val.(string)
+		for i := 0; i < len(format); i++ {
+			if format[i] != '%' {
+				continue
+			}
+			i++
+			if i >= len(format) {
+				return warns, []error{fmt.Errorf("%s has a trailing %% with no directive", key)}
+			}
+
+			// literal percent
+			if format[i] == '%' {
+				continue
+			}
+
+			var braced string
+			if format[i] == '{' {
+				// a `\}` inside the braces is an escaped literal, not the
+				// terminator (e.g. strftime formats in %{...}V directives)
+				end := i + 1
+				for end < len(format) && (format[end] != '}' || format[end-1] == '\\') {
+					end++
+				}
+				if end >= len(format) {
+					return warns, []error{fmt.Errorf("%s has an unterminated brace in directive starting at position %d", key, i-1)}
+				}
+				braced = format[i+1 : end]
+				i = end + 1
+				if i >= len(format) {
+					return warns, []error{fmt.Errorf("%s directive %%{%s} is missing its type letter (e.g. `V` or `i`)", key, braced)}
+				}
+			}
+
+			// skip the `>`/`<` modifiers of directives like %>s
+			for i < len(format) && (format[i] == '>' || format[i] == '<') {
+				i++
+			}
+			if i >= len(format) || !strings.ContainsRune(logFormatDirectiveLetters, rune(format[i])) {
+				directive := "%"
+				if braced != "" {
+					directive = fmt.Sprintf("%%{%s}", braced)
+				}
+				got := "end of string"
+				if i < len(format) {
+					got = fmt.Sprintf("%q", string(format[i]))
+				}
+				return warns, []error{fmt.Errorf("%s has an unknown directive letter after %s: got %s", key, directive, got)}
+			}
+
+			if format[i] == 'V' && braced != "" && logFormatPlainVariableRegexp.MatchString(braced) {
+				known := false
+				for _, prefix := range logFormatVCLPrefixes {
+					if strings.HasPrefix(braced, prefix) || braced == strings.TrimSuffix(prefix, ".") {
+						known = true
+						break
+					}
+				}
+				if !known {
+					warns = append(warns, fmt.Sprintf("%s references %%{%s}V, which does not look like a known VCL variable", key, braced))
+				}
+			}
+		}
+
+		return warns, nil
+	}
+}
+
+// logFormatPlainVariableRegexp matches a bare VCL variable name, as opposed to
+// an expression, inside a %{...}V directive.
+var logFormatPlainVariableRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.:-]+$`)
+
+func validateKafkaRequiredACKs() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"1",
+		"0",
+		"-1",
 	}, false)
 }
 
@@ -97,6 +379,16 @@ func validateRuleStatusType() schema.SchemaValidateFunc {
 	}, false)
 }
 
+const (
+	// dictionaryItemMaxKeyLength is the longest item key the Fastly API
+	// accepts.
+	dictionaryItemMaxKeyLength = 255
+
+	// dictionaryItemMaxValueLength is the longest item value the Fastly API
+	// accepts.
+	dictionaryItemMaxValueLength = 8000
+)
+
 func validateDictionaryItems() schema.SchemaValidateFunc {
 
 	max := gofastly.MaximumDictionarySize
@@ -114,11 +406,77 @@ func validateDictionaryItems() schema.SchemaValidateFunc {
 			return
 		}
 
+		// Warn before the count reaches the per-dictionary limit, so the cap
+		// is not a surprise on the apply that crosses it.
+		if len(v) > max*8/10 {
+			s = append(s, fmt.Sprintf("%s holds %d items, approaching the %d-item limit Fastly applies per dictionary by default", k, len(v), max))
+		}
+
+		// Check the per-item limits here rather than during the batch apply,
+		// which would otherwise fail with part of the batch already written.
+		for key, value := range v {
+			if len(key) > dictionaryItemMaxKeyLength {
+				es = append(es, fmt.Errorf("expected each key of %s to be at most %d characters, got %q (%d characters)", k, dictionaryItemMaxKeyLength, key, len(key)))
+			}
+			if str, ok := value.(string); ok && len(str) > dictionaryItemMaxValueLength {
+				es = append(es, fmt.Errorf("expected the value of %s key %q to be at most %d characters, got %d", k, key, dictionaryItemMaxValueLength, len(str)))
+			}
+		}
+
 		return
 	}
 
 }
 
+// validateACLEntryIP checks an ACL entry `ip` is a literal IPv4 or IPv6
+// address. A CIDR here is the most common mistake — the prefix length belongs
+// in the separate `subnet` attribute — and otherwise only fails part-way
+// through a batch API call.
+func validateACLEntryIP() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if strings.Contains(v, "/") {
+			return nil, []error{fmt.Errorf("expected %s to be an IP address without a prefix length; put the prefix length in `subnet` instead, got %q", key, v)}
+		}
+		if net.ParseIP(v) == nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid IPv4 or IPv6 address, got %q", key, v)}
+		}
+		return nil, nil
+	}
+}
+
+// validateACLEntrySubnet checks an ACL entry `subnet` is a bare prefix length.
+// Whether it fits the address family (32 for IPv4, 128 for IPv6) is checked
+// against the `ip` attribute in validateACLEntrySemantics.
+func validateACLEntrySubnet() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if v == "" {
+			return nil, nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, []error{fmt.Errorf("expected %s to be a prefix length such as `24`, got %q", key, v)}
+		}
+		if n < 0 || n > 128 {
+			return nil, []error{fmt.Errorf("expected %s to be a prefix length between 0 and 128, got %d", key, n)}
+		}
+		return nil, nil
+	}
+}
+
+// validateACLEntryComment warns on comments longer than the 255 characters
+// Fastly stores for freeform fields, which would otherwise be truncated
+// silently.
+func validateACLEntryComment() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		if v := val.(string); len(v) > 255 {
+			return []string{fmt.Sprintf("%s is %d characters; Fastly stores at most 255 and will truncate the rest", key, len(v))}, nil
+		}
+		return nil, nil
+	}
+}
+
 func validateUserRole() schema.SchemaValidateFunc {
 	return validation.StringInSlice(
 		[]string{
@@ -160,6 +518,58 @@ func validatePEMBlock(pemType string) schema.SchemaValidateFunc {
 	}
 }
 
+// vclPlaceholder matches `%{...}V` interpolations in a log format string, e.g. `%{json.escape(req.http.Host)}V`.
+var vclPlaceholder = regexp.MustCompile(`%\{[^}]*\}V`)
+
+// validateLoggingJSONFormat returns a schema validation function for `format` on logging endpoints that ingest JSON.
+// It substitutes `%{...}V` placeholders with a literal and checks that the result parses as JSON, warning (rather than
+// failing) on mismatches so Apache-style formats carried over from other endpoints surface at plan time instead of as
+// silent log-delivery failures.
+func validateLoggingJSONFormat() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		substituted := vclPlaceholder.ReplaceAllString(val.(string), "0")
+		if !json.Valid([]byte(substituted)) {
+			return []string{fmt.Sprintf("%s does not parse as JSON: this endpoint expects JSON-formatted logs, and non-JSON records may be silently dropped", key)}, nil
+		}
+		return nil, nil
+	}
+}
+
+// validatePEMCertificates returns a schema validation function that checks whether a string contains one or more
+// PEM-format CERTIFICATE blocks. An empty string passes, so it can be used on optional attributes that default to "".
+func validatePEMCertificates() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		if val.(string) == "" {
+			return nil, nil
+		}
+		return validatePEMBlocks("CERTIFICATE")(val, key)
+	}
+}
+
+// validatePEMPrivateKey returns a schema validation function that checks whether a string contains a single PEM-format
+// private key block. Any of the common private key block types (`PRIVATE KEY`, `RSA PRIVATE KEY`, `EC PRIVATE KEY`) is
+// accepted. An empty string passes, so it can be used on optional attributes that default to "".
+func validatePEMPrivateKey() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) ([]string, []error) {
+		v := val.(string)
+		if v == "" {
+			return nil, nil
+		}
+
+		b, rest := pem.Decode([]byte(v))
+		if b == nil {
+			return nil, []error{fmt.Errorf("expected %s to be a valid PEM-format block", key)}
+		}
+		if !strings.HasSuffix(b.Type, "PRIVATE KEY") {
+			return nil, []error{fmt.Errorf("expected %s to be a valid PEM-format private key block, got type '%s'", key, b.Type)}
+		}
+		if len(rest) != 0 {
+			return nil, []error{fmt.Errorf("expected %s to only contain one PEM-format block", key)}
+		}
+		return nil, nil
+	}
+}
+
 // validatePEMBlocks returns a schema validation function that checks whether a string contains multiple PEM blocks of
 // type `pemType`.
 func validatePEMBlocks(pemType string) schema.SchemaValidateFunc {
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/v3/fastly"
@@ -14,7 +15,7 @@ func TestValidateLoggingFormatVersion(t *testing.T) {
 		expectedErrors int
 	}{
 		"0": {0, 0, 1},
-		"1": {1, 0, 0},
+		"1": {1, 1, 0},
 		"2": {2, 0, 0},
 		"3": {3, 0, 1},
 		"4": {4, 0, 1},
@@ -66,7 +67,7 @@ func TestValidateLoggingPlacement(t *testing.T) {
 		expectedErrors int
 	}{
 		{"none", 0, 0},
-		{"waf_debug", 0, 0},
+		{"waf_debug", 0, 1},
 		{"NONE", 0, 1},
 		{"WAF_DEBUG", 0, 1},
 	} {
@@ -314,8 +315,12 @@ func TestValidateDictionaryItemMaxSize(t *testing.T) {
 		expectedErrors int
 	}{
 		"Ten hundred dictionary items":          {createTestDictionaryItems(10), 0, 0},
-		"Ten thousand dictionary items":         {createTestDictionaryItems(gofastly.MaximumDictionarySize), 0, 0},
+		"Ten thousand dictionary items":         {createTestDictionaryItems(gofastly.MaximumDictionarySize), 1, 0},
 		"Ten thousand and one dictionary items": {createTestDictionaryItems(gofastly.MaximumDictionarySize + 1), 0, 1},
+		"Key at the length limit":               {map[string]interface{}{strings.Repeat("k", dictionaryItemMaxKeyLength): "value"}, 0, 0},
+		"Key over the length limit":             {map[string]interface{}{strings.Repeat("k", dictionaryItemMaxKeyLength+1): "value"}, 0, 1},
+		"Value at the length limit":             {map[string]interface{}{"key": strings.Repeat("v", dictionaryItemMaxValueLength)}, 0, 0},
+		"Value over the length limit":           {map[string]interface{}{"key": strings.Repeat("v", dictionaryItemMaxValueLength+1)}, 0, 1},
 	} {
 		t.Run(name, func(t *testing.T) {
 			actualWarns, actualErrors := validateDictionaryItems()(testcase.value, "dictionary_items")
@@ -450,3 +455,292 @@ func TestValidateMultiplePEMBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePEMCertificates(t *testing.T) {
+	key, cert, ca, err := generateKeyAndCertWithCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range map[string]struct {
+		value            string
+		expectedWarnings int
+		expectedErrors   int
+	}{
+		"single cert":  {cert, 0, 0},
+		"cert chain":   {fmt.Sprintf("%s\n%s", cert, ca), 0, 0},
+		"empty string": {"", 0, 0},
+		"private key":  {key, 0, 1},
+		"gibberish":    {"jkljansdfj\nasldfjhadskjfh\nlshakdjf", 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarnings, actualErrors := validatePEMCertificates()(testCase.value, "tls_ca_cert")
+
+			if len(actualWarnings) != testCase.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d", testCase.expectedWarnings, len(actualWarnings))
+			}
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidatePEMPrivateKey(t *testing.T) {
+	key, cert, _, err := generateKeyAndCertWithCA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, testCase := range map[string]struct {
+		value            string
+		expectedWarnings int
+		expectedErrors   int
+	}{
+		"private key":    {key, 0, 0},
+		"empty string":   {"", 0, 0},
+		"certificate":    {cert, 0, 1},
+		"key plus extra": {fmt.Sprintf("%s\n%s", key, cert), 0, 1},
+		"gibberish":      {"jkljansdfj\nasldfjhadskjfh\nlshakdjf", 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarnings, actualErrors := validatePEMPrivateKey()(testCase.value, "tls_client_key")
+
+			if len(actualWarnings) != testCase.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d", testCase.expectedWarnings, len(actualWarnings))
+			}
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateLoggingJSONFormat(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value            string
+		expectedWarnings int
+	}{
+		"json object":           {`{"host":"%{json.escape(req.http.Host)}V","size":%{resp.size}V}`, 0},
+		"apache style":          {`%h %l %u %t "%r" %>s %b`, 1},
+		"misquoted placeholder": {`{"host":%{req.http.Host}V"}`, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarnings, actualErrors := validateLoggingJSONFormat()(testCase.value, "format")
+
+			if len(actualWarnings) != testCase.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d", testCase.expectedWarnings, len(actualWarnings))
+			}
+			if len(actualErrors) != 0 {
+				t.Errorf("expected no errors, got %d", len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateKafkaBrokers(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedErrors int
+	}{
+		"single host":         {"broker.example.com", 0},
+		"host with port":      {"broker.example.com:9092", 0},
+		"multiple brokers":    {"b1.example.com:9092,b2.example.com:9092", 0},
+		"spaces after commas": {"b1.example.com, b2.example.com", 0},
+		"empty":               {"", 1},
+		"space separated":     {"b1.example.com b2.example.com", 1},
+		"trailing comma":      {"b1.example.com,", 1},
+		"bad port":            {"b1.example.com:rot", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, actualErrors := validateKafkaBrokers()(testCase.value, "brokers")
+
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateDomainName(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		"plain fqdn":            {"www.example.com", 0, 0},
+		"trailing dot":          {"www.example.com.", 0, 0},
+		"wildcard":              {"*.example.com", 0, 0},
+		"idn":                   {"bücher.example.com", 1, 0},
+		"empty":                 {"", 0, 1},
+		"single label":          {"localhost", 0, 1},
+		"embedded wildcard":     {"www.*.example.com", 0, 1},
+		"empty label":           {"www..example.com", 0, 1},
+		"leading hyphen label":  {"-www.example.com", 0, 1},
+		"invalid character":     {"www.exa_mple.com", 0, 1},
+		"label over 63 chars":   {strings.Repeat("a", 64) + ".example.com", 0, 1},
+		"domain over 253 chars": {strings.Repeat("aaaaaaaaa.", 26) + "example.com", 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := validateDomainName()(testCase.value, "name")
+
+			if len(actualWarns) != testCase.expectedWarns {
+				t.Errorf("expected %d warnings, got %d: %v", testCase.expectedWarns, len(actualWarns), actualWarns)
+			}
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d: %v", testCase.expectedErrors, len(actualErrors), actualErrors)
+			}
+		})
+	}
+}
+
+func TestValidateGzipExtension(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedErrors int
+	}{
+		"plain extension": {"css", 0},
+		"empty":           {"", 1},
+		"leading dot":     {".css", 1},
+		"whitespace":      {"css js", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, actualErrors := validateGzipExtension()(testCase.value, "extensions")
+
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateGzipContentType(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedErrors int
+	}{
+		"plain media type":    {"text/html", 0},
+		"media type with sub": {"image/svg+xml", 0},
+		"empty":               {"", 1},
+		"no subtype":          {"html", 1},
+		"whitespace":          {"text/html text/css", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, actualErrors := validateGzipContentType()(testCase.value, "content_types")
+
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateLogFormat(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		"classic apache":       {`%h %l %u %t "%r" %>s %b`, 0, 0},
+		"literal percent":      {`100%% done`, 0, 0},
+		"header lookup":        {`%{User-Agent}i`, 0, 0},
+		"vcl variable":         {`%{req.http.Host}V`, 0, 0},
+		"vcl expression":       {`%{json.escape(req.url)}V`, 0, 0},
+		"unknown vcl variable": {`%{reqq.http.Host}V`, 1, 0},
+		"trailing percent":     {`%h %`, 0, 1},
+		"unterminated brace":   {`%{User-Agent`, 0, 1},
+		"missing type letter":  {`%{User-Agent}`, 0, 1},
+		"unknown directive":    {`%h %J`, 0, 1},
+		"unknown after brace":  {`%{User-Agent}-`, 0, 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := validateLogFormat()(testCase.value, "format")
+
+			if len(actualWarns) != testCase.expectedWarns {
+				t.Errorf("expected %d warnings, got %d: %v", testCase.expectedWarns, len(actualWarns), actualWarns)
+			}
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d: %v", testCase.expectedErrors, len(actualErrors), actualErrors)
+			}
+		})
+	}
+
+	// The curated presets must themselves pass the validator.
+	for name, versions := range logFormatPresets {
+		for version, format := range versions {
+			warns, errs := validateLogFormat()(format, "format")
+			if len(warns) != 0 || len(errs) != 0 {
+				t.Errorf("preset %s (format_version %d): expected no diagnostics, got warns %v errors %v", name, version, warns, errs)
+			}
+		}
+	}
+}
+
+func TestValidateACLEntryIP(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedErrors int
+	}{
+		"ipv4":          {"127.0.0.1", 0},
+		"ipv6":          {"2001:db8::1", 0},
+		"cidr":          {"127.0.0.0/24", 1},
+		"hostname":      {"example.com", 1},
+		"empty":         {"", 1},
+		"partial":       {"127.0.0", 1},
+		"out of bounds": {"256.0.0.1", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, actualErrors := validateACLEntryIP()(testCase.value, "ip")
+
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateACLEntrySubnet(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value          string
+		expectedErrors int
+	}{
+		"empty":        {"", 0},
+		"zero":         {"0", 0},
+		"ipv4 prefix":  {"24", 0},
+		"ipv6 prefix":  {"128", 0},
+		"too large":    {"129", 1},
+		"negative":     {"-1", 1},
+		"not a number": {"255.255.255.0", 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, actualErrors := validateACLEntrySubnet()(testCase.value, "subnet")
+
+			if len(actualErrors) != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got %d", testCase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateACLEntryComment(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		value         string
+		expectedWarns int
+	}{
+		"empty":    {"", 0},
+		"short":    {"office range", 0},
+		"at limit": {strings.Repeat("a", 255), 0},
+		"too long": {strings.Repeat("a", 256), 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := validateACLEntryComment()(testCase.value, "comment")
+
+			if len(actualWarns) != testCase.expectedWarns {
+				t.Errorf("expected %d warnings, got %d", testCase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != 0 {
+				t.Errorf("expected no errors, got %d", len(actualErrors))
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// vclLintResult models the response of the Fastly VCL linting endpoint.
+type vclLintResult struct {
+	Status  string   `json:"status"`
+	Message string   `json:"msg"`
+	Errors  []string `json:"errors"`
+}
+
+// lintVCL submits a single piece of custom VCL to the Fastly VCL linting
+// endpoint. Compilation problems are returned as an error carrying the
+// file/line details reported by the compiler, prefixed with the name of the
+// offending block so it can be located in the configuration.
+func lintVCL(conn *gofastly.Client, name, content string) error {
+	resp, err := conn.PostForm("/vcl_lint", &struct {
+		Content string `form:"content"`
+	}{content}, nil)
+	if err != nil {
+		return fmt.Errorf("[ERR] Error validating VCL (%s): %s", name, err)
+	}
+	defer resp.Body.Close()
+
+	var lr vclLintResult
+	if err := json.NewDecoder(resp.Body).Decode(&lr); err != nil {
+		return fmt.Errorf("[ERR] Error decoding VCL validation response for (%s): %s", name, err)
+	}
+
+	if lr.Status != "ok" {
+		if len(lr.Errors) > 0 {
+			return fmt.Errorf("[ERR] Invalid VCL (%s): %s", name, strings.Join(lr.Errors, "; "))
+		}
+		return fmt.Errorf("[ERR] Invalid VCL (%s): %s", name, lr.Message)
+	}
+
+	log.Printf("[DEBUG] VCL (%s) passed validation", name)
+	return nil
+}
+
+// lintCustomVCL runs any changed custom VCL and snippet content through the
+// Fastly VCL linting endpoint. This happens before a version is cloned, so
+// VCL that fails to compile never burns a service version.
+func lintCustomVCL(d *schema.ResourceData, conn *gofastly.Client) error {
+	for _, key := range []string{"vcl", "snippet"} {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		vals, exists := d.GetOk(key)
+		if !exists {
+			continue
+		}
+
+		for _, elem := range vals.(*schema.Set).List() {
+			block := elem.(map[string]interface{})
+			if err := lintVCL(conn, block["name"].(string), block["content"].(string)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
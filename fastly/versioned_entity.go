@@ -0,0 +1,95 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/v3/fastly"
+)
+
+// modifyServiceVersion clones the active version of a service into a new
+// draft, applies f to the writable version, validates the result and (when
+// activate is true) activates it. When the service has never been activated
+// the latest draft is modified in place instead of cloning. It returns the
+// version number the change landed in.
+//
+// Callers are expected to hold the service lock so standalone per-entity
+// resources and the monolithic service resource do not race each other
+// cloning versions of the same service.
+func modifyServiceVersion(conn *gofastly.Client, serviceID string, activate bool, f func(version int) error) (int, error) {
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: serviceID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("[ERR] Error looking up Fastly Service (%s): %s", serviceID, err)
+	}
+
+	version := s.ActiveVersion.Number
+	if version == 0 {
+		// The service has never been activated; its latest version is a draft
+		// that can be modified directly.
+		version = s.Version.Number
+	} else {
+		log.Printf("[DEBUG] Creating clone of version (%d) for updates", version)
+		newVersion, err := conn.CloneVersion(&gofastly.CloneVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		})
+		if err != nil {
+			return 0, err
+		}
+		version = newVersion.Number
+
+		// As in the service resource, a freshly cloned version is not
+		// immediately mutable.
+		log.Print("[DEBUG] Sleeping 7 seconds to allow Fastly Version to be available")
+		time.Sleep(7 * time.Second)
+	}
+
+	if err := f(version); err != nil {
+		return 0, err
+	}
+
+	log.Printf("[DEBUG] Validating Fastly Service (%s), Version (%v)", serviceID, version)
+	valid, msg, err := conn.ValidateVersion(&gofastly.ValidateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("[ERR] Error checking validation: %s", err)
+	}
+	if !valid {
+		return 0, fmt.Errorf("[ERR] Invalid configuration for Fastly Service (%s), version (%v) was not activated: %s", serviceID, version, msg)
+	}
+
+	if activate {
+		log.Printf("[DEBUG] Activating Fastly Service (%s), Version (%v)", serviceID, version)
+		if _, err := conn.ActivateVersion(&gofastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: version,
+		}); err != nil {
+			return 0, fmt.Errorf("[ERR] Error activating version (%d): %s", version, err)
+		}
+	}
+
+	return version, nil
+}
+
+// readServiceVersion returns the version a standalone entity resource should
+// refresh from: the active version when one exists and the resource activates
+// its changes, otherwise the latest version, which is where unactivated drafts
+// land.
+func readServiceVersion(conn *gofastly.Client, serviceID string, activate bool) (int, error) {
+	s, err := conn.GetServiceDetails(&gofastly.GetServiceInput{
+		ID: serviceID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if s.ActiveVersion.Number != 0 && activate {
+		return s.ActiveVersion.Number, nil
+	}
+	return s.Version.Number, nil
+}
@@ -0,0 +1,16 @@
+package fastly
+
+// explicitZeroNumericFields returns the subset of keys whose configured value
+// is an explicit zero. The API client omits zero-valued numerics from create
+// payloads ("omitempty" form encoding), so the API silently applies its own
+// non-zero defaults instead; callers follow the create with an update, whose
+// pointer-typed fields can carry the zero intentionally.
+func explicitZeroNumericFields(resource map[string]interface{}, keys ...string) map[string]interface{} {
+	modified := make(map[string]interface{})
+	for _, k := range keys {
+		if v, ok := resource[k].(int); ok && v == 0 {
+			modified[k] = 0
+		}
+	}
+	return modified
+}
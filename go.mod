@@ -8,5 +8,7 @@ require (
 	github.com/google/go-cmp v0.5.2
 	github.com/hashicorp/terraform-plugin-docs v0.4.0
 	github.com/hashicorp/terraform-plugin-sdk v1.1.0
+	github.com/mitchellh/mapstructure v1.1.2
 	github.com/stretchr/testify v1.6.1
+	golang.org/x/net v0.0.0-20200301022130-244492dfa37a
 )